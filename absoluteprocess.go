@@ -1,8 +1,6 @@
 package timestring
 
 import (
-	"fmt"
-	"strings"
 	"time"
 )
 
@@ -17,6 +15,11 @@ var Absolute Formatter = AbsoluteFormatter{abbreviated: true}
 type AbsoluteFormatter struct {
 	nospaces     bool
 	nounitspaces bool
+	units        UnitSet
+	list         ListStyle
+	pad          Padding
+	sentinels    Sentinels
+	numbers      NumberFormatter
 	abbreviated  bool // Always true for AbsoluteFormatter, but kept for interface compatibility
 }
 
@@ -40,40 +43,95 @@ func (s AbsoluteFormatter) Option(opts ...FormatterOption) Formatter {
 	return s
 }
 
+// WithUnits returns a Absolute Formatter that splits durations into the supplied units
+// instead of PreciseUnits.
+func (s AbsoluteFormatter) WithUnits(units UnitSet) AbsoluteFormatter {
+	s.units = units
+
+	return s
+}
+
+// WithListStyle returns a Absolute Formatter that joins the parts of the duration using
+// the supplied list style (eg. "1h:5m:3s"), this replaces NoSpaces.
+func (s AbsoluteFormatter) WithListStyle(ls ListStyle) AbsoluteFormatter {
+	s.list = ls
+
+	return s
+}
+
+// WithPadding returns a Absolute Formatter that pads the value of each unit to a fixed width
+// (eg. "01d 02h" or " 1d  2h").
+func (s AbsoluteFormatter) WithPadding(pad Padding) AbsoluteFormatter {
+	s.pad = pad
+
+	return s
+}
+
+// WithSentinels returns a Absolute Formatter that displays the supplied strings in place of
+// zero, sub-resolution, maximum and minimum durations.
+func (s AbsoluteFormatter) WithSentinels(sentinels Sentinels) AbsoluteFormatter {
+	s.sentinels = sentinels
+
+	return s
+}
+
+// WithNumberFormat returns a Absolute Formatter that formats the value of each unit using the
+// supplied number formatter (eg. NumberEnglish for "12,345 days"), a nil formatter uses strconv.
+func (s AbsoluteFormatter) WithNumberFormat(nf NumberFormatter) AbsoluteFormatter {
+	s.numbers = nf
+
+	return s
+}
+
 // String returns a human readable string using the Absolute Formatter.
 // It always uses abbreviated units and omits zero-value units.
 //
 // Example: "1d 2h 3m 4s", "2h 3m", "4s", "0s".
 func (s AbsoluteFormatter) String(td time.Duration) string {
-	if td == 0 {
-		return "0s" // Absolute formatter usually returns 0s even with spaces.
+	units := s.visibleUnits(td)
+	if str, ok := s.sentinels.replace(td, units); ok {
+		return str
+	}
+
+	parts := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		parts = append(parts, s.renderUnit(unit))
 	}
 
-	d := TimeDurationToDuration(td)
-	units := []timeUnit{
-		unitDay.toTimeUnit(d.Days),
-		unitHour.toTimeUnit(d.Hours),
-		unitMinute.toTimeUnit(d.Minutes),
-		unitSecond.toTimeUnit(d.Seconds),
-		unitMillisecond.toTimeUnit(d.Milliseconds),
-		unitMicrosecond.toTimeUnit(d.Microseconds),
-		unitNanosecond.toTimeUnit(d.Nanoseconds),
+	return joinParts(parts, s.list, s.nospaces)
+}
+
+// renderUnit returns the string representation of a single unit.
+func (s AbsoluteFormatter) renderUnit(unit timeUnit) string {
+	return s.pad.format(unit, s.renderNumber(unit), true, false)
+}
+
+// renderNumber returns the string representation of the value of a single unit.
+func (s AbsoluteFormatter) renderNumber(unit timeUnit) string {
+	return formatUnitValue(s.numbers, unit)
+}
+
+// visibleUnits returns the units of the duration that should be displayed.
+func (s AbsoluteFormatter) visibleUnits(td time.Duration) []timeUnit {
+	set := s.units.orDefault(PreciseUnits)
+	if td == 0 {
+		// Absolute formatter usually returns 0s even with spaces.
+		return []timeUnit{set.zeroUnit().toTimeUnit(0)}
 	}
-	sb := &strings.Builder{}
+
+	units := set.timeUnits(td)
+	visible := make([]timeUnit, 0, len(units))
 
 	for _, unit := range units {
 		if unit.value > 0 {
-			if s.nospaces {
-				fmt.Fprintf(sb, "%d%s", unit.value, unit.GetNameAbbrev())
-			} else {
-				fmt.Fprintf(sb, "%d%s ", unit.value, unit.GetNameAbbrev())
-			}
+			visible = append(visible, unit)
 		}
 	}
 
-	if sb.Len() == 0 {
-		return "0s"
+	if len(visible) == 0 {
+		return []timeUnit{set.zeroUnit().toTimeUnit(0)}
 	}
 
-	return strings.TrimSpace(sb.String())
+	return visible
 }
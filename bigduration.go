@@ -0,0 +1,337 @@
+package timestring
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// Predefined units for durations longer than a day, a year is 365 days and a century is 100 years.
+//
+// A century is the largest unit that fits in a time.Duration, larger units can not be represented.
+//
+//nolint:gochecknoglobals,mnd // These are constants for time units, not global state.
+var (
+	UnitCentury = Unit{
+		NameSingular: "century", NamePlural: "centuries", NameAbbrev: "c", Size: 100 * 365 * 24 * time.Hour,
+	}
+	UnitYear = Unit{
+		NameSingular: "year", NamePlural: "years", NameAbbrev: "y", Size: 365 * 24 * time.Hour,
+	}
+)
+
+// Predefined unit sets used by the formatters for BigDuration values.
+//
+//nolint:gochecknoglobals // These are constants for unit sets, not global state.
+var (
+	// BigUnits is the set of units used by LongProcess and ShortProcess for BigDuration values
+	// (centuries down to milliseconds).
+	BigUnits = NewUnitSet(UnitCentury, UnitYear, UnitDay, UnitHour, UnitMinute, UnitSecond, UnitMillisecond)
+
+	// BigPreciseUnits is the set of units used by Absolute for BigDuration values (centuries down to nanoseconds).
+	BigPreciseUnits = NewUnitSet(
+		UnitCentury, UnitYear, UnitDay, UnitHour, UnitMinute, UnitSecond,
+		UnitMillisecond, UnitMicrosecond, UnitNanosecond,
+	)
+)
+
+// bigPartSizes are the sizes of the fields of BigDurationParts, these are fixed so that they are
+// not affected by changes to the exported units.
+//
+//nolint:gochecknoglobals,mnd // lookup table.
+var bigPartSizes = []time.Duration{
+	100 * 365 * 24 * time.Hour, 365 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second,
+	time.Millisecond, time.Microsecond, time.Nanosecond,
+}
+
+// BigDuration is an arbitrary-precision duration in nanoseconds, for spans longer than the
+// roughly 292 years that fit in a time.Duration (eg. archival retention periods or simulation times).
+//
+// The zero value is a zero duration, BigDuration values are immutable.
+type BigDuration struct {
+	ns *big.Int
+}
+
+// NewBigDuration returns the time.Duration as a BigDuration.
+func NewBigDuration(td time.Duration) BigDuration {
+	return BigDuration{ns: big.NewInt(int64(td))}
+}
+
+// BigDurationOf returns a BigDuration of n units (eg. BigDurationOf(big.NewInt(65e6), UnitYear.Size)).
+func BigDurationOf(n *big.Int, unit time.Duration) BigDuration {
+	return BigDuration{ns: new(big.Int).Mul(n, big.NewInt(int64(unit)))}
+}
+
+// value returns the number of nanoseconds, the result must not be modified.
+func (d BigDuration) value() *big.Int {
+	if d.ns == nil {
+		return new(big.Int)
+	}
+
+	return d.ns
+}
+
+// Nanoseconds returns a copy of the duration as a number of nanoseconds.
+func (d BigDuration) Nanoseconds() *big.Int {
+	return new(big.Int).Set(d.value())
+}
+
+// Duration returns the duration as a time.Duration, ok is false if it does not fit.
+func (d BigDuration) Duration() (time.Duration, bool) {
+	if !d.value().IsInt64() {
+		return 0, false
+	}
+
+	return time.Duration(d.value().Int64()), true
+}
+
+// Add returns the sum of d and other.
+func (d BigDuration) Add(other BigDuration) BigDuration {
+	return BigDuration{ns: new(big.Int).Add(d.value(), other.value())}
+}
+
+// Sub returns the difference of d and other.
+func (d BigDuration) Sub(other BigDuration) BigDuration {
+	return BigDuration{ns: new(big.Int).Sub(d.value(), other.value())}
+}
+
+// Mul returns the duration multiplied by n.
+func (d BigDuration) Mul(n int64) BigDuration {
+	return BigDuration{ns: new(big.Int).Mul(d.value(), big.NewInt(n))}
+}
+
+// Neg returns the duration with the sign reversed.
+func (d BigDuration) Neg() BigDuration {
+	return BigDuration{ns: new(big.Int).Neg(d.value())}
+}
+
+// Sign returns -1 if the duration is negative, 0 if it is zero and +1 if it is positive.
+func (d BigDuration) Sign() int {
+	return d.value().Sign()
+}
+
+// Cmp compares d and other and returns -1 if d is shorter, 0 if they are equal and +1 if d is longer.
+func (d BigDuration) Cmp(other BigDuration) int {
+	return d.value().Cmp(other.value())
+}
+
+// String returns the duration using the Long Process Formatter.
+func (d BigDuration) String() string {
+	return LongProcessFormatter{}.BigString(d)
+}
+
+// BigDurationParts contains the absolute number of each field of a BigDuration with all fields
+// adding up to the total duration, like Duration, Centuries is the only field that is not limited in size.
+type BigDurationParts struct {
+	Centuries    *big.Int
+	Years        int64
+	Days         int64
+	Hours        int64
+	Minutes      int64
+	Seconds      int64
+	Milliseconds int64
+	Microseconds int64
+	Nanoseconds  int64
+}
+
+// Parts returns the duration split into centuries, years, days and smaller fields,
+// the fields of a negative duration are all negative or zero.
+func (d BigDuration) Parts() BigDurationParts {
+	values := splitBigSizes(d.value(), bigPartSizes)
+
+	return BigDurationParts{
+		Centuries:    values[0],
+		Years:        values[1].Int64(),
+		Days:         values[2].Int64(),
+		Hours:        values[3].Int64(),
+		Minutes:      values[4].Int64(),
+		Seconds:      values[5].Int64(),
+		Milliseconds: values[6].Int64(),
+		Microseconds: values[7].Int64(),
+		Nanoseconds:  values[8].Int64(),
+	}
+}
+
+// BigFormatter is implemented by formatters that can display a BigDuration.
+type BigFormatter interface {
+	BigString(bd BigDuration) string
+}
+
+// FormatBigDuration returns the BigDuration formatted using the formatter, durations that do not
+// fit in a time.Duration are displayed with BigString if the formatter is a BigFormatter,
+// otherwise ErrDurationOverflow is returned.
+func FormatBigDuration(f Formatter, bd BigDuration) (string, error) {
+	if td, ok := bd.Duration(); ok {
+		return f.String(td), nil
+	}
+
+	if bf, ok := f.(BigFormatter); ok {
+		return bf.BigString(bd), nil
+	}
+
+	return "", fmt.Errorf("%w: %s", ErrDurationOverflow, bd)
+}
+
+// BigString returns a human readable string of the BigDuration using the Long Process Formatter.
+//
+// When no units are set with WithUnits, the formatter uses BigUnits instead of StandardUnits, or
+// BigPreciseUnits when the smallest unit of WithAllUnits is smaller than a millisecond.
+func (a LongProcessFormatter) BigString(bd BigDuration) string {
+	a.units = a.unitSet(BigUnits, BigPreciseUnits)
+	if td, ok := bd.Duration(); ok {
+		return a.String(td)
+	}
+
+	units := a.units.bigTimeUnits(bd.value())
+	if a.allunits {
+		units = a.allVisibleUnits(units)
+	} else {
+		units = visibleBigUnits(units, a.units, false)
+	}
+
+	parts := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		parts = append(parts, a.renderUnit(unit))
+	}
+
+	return joinParts(parts, a.list, a.nospaces)
+}
+
+// BigString returns a human readable string of the BigDuration using the Short Process Formatter.
+//
+// When no units are set with WithUnits, the formatter uses BigUnits instead of StandardUnits.
+func (s ShortProcessFormatter) BigString(bd BigDuration) string {
+	s.units = s.units.orDefault(BigUnits)
+	if td, ok := bd.Duration(); ok {
+		return s.String(td)
+	}
+
+	units := visibleBigUnits(s.units.bigTimeUnits(bd.value()), s.units, true)
+	parts := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		parts = append(parts, s.renderUnit(unit))
+	}
+
+	return joinParts(parts, s.list, s.nospaces)
+}
+
+// BigString returns a human readable string of the BigDuration using the Absolute Formatter.
+//
+// When no units are set with WithUnits, the formatter uses BigPreciseUnits instead of PreciseUnits.
+func (s AbsoluteFormatter) BigString(bd BigDuration) string {
+	s.units = s.units.orDefault(BigPreciseUnits)
+	if td, ok := bd.Duration(); ok {
+		return s.String(td)
+	}
+
+	units := visibleBigUnits(s.units.bigTimeUnits(bd.value()), s.units, true)
+	parts := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		parts = append(parts, s.renderUnit(unit))
+	}
+
+	return joinParts(parts, s.list, s.nospaces)
+}
+
+// visibleBigUnits returns the units of a duration too long for a time.Duration that should be
+// displayed, positiveOnly displays only positive values (like ShortProcess), otherwise units are
+// displayed like LongProcess and sub-second units are never displayed.
+func visibleBigUnits(units []timeUnit, set UnitSet, positiveOnly bool) []timeUnit {
+	visible := make([]timeUnit, 0, len(units))
+
+	for _, unit := range units {
+		if positiveOnly {
+			if unit.value > 0 {
+				visible = append(visible, unit)
+			}
+
+			continue
+		}
+
+		if unit.IsOnlyIfSeconds() || (unit.value == 0 && (!unit.IsShowZero() || len(visible) > 0)) {
+			continue
+		}
+
+		visible = append(visible, unit)
+	}
+
+	if len(visible) == 0 {
+		return []timeUnit{set.zeroUnit().toTimeUnit(0)}
+	}
+
+	return visible
+}
+
+// splitBig returns the value of each unit in the set for the number of nanoseconds, in the same
+// order as Units(). The remainder smaller than the smallest unit is discarded.
+func (us UnitSet) splitBig(ns *big.Int) []*big.Int {
+	set := us.list()
+	sizes := make([]time.Duration, len(set))
+	for i, unit := range set {
+		sizes[i] = unit.Size
+	}
+
+	return splitBigSizes(ns, sizes)
+}
+
+// splitBigSizes returns the number of each size in the number of nanoseconds, sizes must be in
+// descending order. The remainder smaller than the smallest size is discarded.
+func splitBigSizes(ns *big.Int, sizes []time.Duration) []*big.Int {
+	values := make([]*big.Int, len(sizes))
+	rem := new(big.Int).Set(ns)
+
+	for i, size := range sizes {
+		values[i], rem = new(big.Int).QuoRem(rem, big.NewInt(int64(size)), new(big.Int))
+	}
+
+	return values
+}
+
+// bigTimeUnits splits the number of nanoseconds into timeUnit values for each unit in the set,
+// values that do not fit in an int64 are kept in the big field of the timeUnit.
+func (us UnitSet) bigTimeUnits(ns *big.Int) []timeUnit {
+	values := us.splitBig(ns)
+	set := us.list()
+	units := make([]timeUnit, len(set))
+
+	for i, unit := range set {
+		units[i] = unit.toBigTimeUnit(values[i])
+	}
+
+	return units
+}
+
+// toBigTimeUnit converts a Unit to a timeUnit with the specified value, a value that does not fit
+// in an int64 is stored in big with value set to the largest int64 of the same sign.
+func (gtu Unit) toBigTimeUnit(value *big.Int) timeUnit {
+	if value.IsInt64() {
+		return gtu.toTimeUnit(value.Int64())
+	}
+
+	tu := gtu.toTimeUnit(math.MaxInt64)
+	if value.Sign() < 0 {
+		tu.value = math.MinInt64
+	}
+
+	tu.big = value
+
+	return tu
+}
+
+// formatUnitValue returns the value of the unit formatted using the number formatter, values that
+// do not fit in an int64 are formatted with NumberFormat or as plain digits.
+func formatUnitValue(nf NumberFormatter, unit timeUnit) string {
+	if unit.big == nil {
+		return formatInt(nf, unit.value)
+	}
+
+	if f, ok := nf.(NumberFormat); ok {
+		return f.format(unit.big.String())
+	}
+
+	return unit.big.String()
+}
@@ -0,0 +1,148 @@
+package timestring_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestBigDuration_String(t *testing.T) {
+	t.Parallel()
+
+	year := ts.UnitYear.Size
+	million := ts.BigDurationOf(big.NewInt(1_000_000), year)
+	archive := ts.BigDurationOf(big.NewInt(350), year).Add(ts.NewBigDuration(3*24*time.Hour + 4*time.Hour))
+
+	tcs := []struct {
+		name string
+		f    ts.BigFormatter
+		bd   ts.BigDuration
+		ex   string
+	}{
+		{"long/zero", ts.LongProcessFormatter{}, ts.BigDuration{}, "0 seconds"},
+		{"long/small", ts.LongProcessFormatter{}, ts.NewBigDuration(90 * time.Minute), "1 hour 30 minutes"},
+		{
+			"long/fits",
+			ts.LongProcessFormatter{},
+			ts.NewBigDuration(2*year + 5*24*time.Hour),
+			"2 years 5 days",
+		},
+		{"long/archive", ts.LongProcessFormatter{}, archive, "3 centuries 50 years 3 days 4 hours"},
+		{"long/million", ts.LongProcessFormatter{}, million, "10000 centuries"},
+		{"long/negative", ts.LongProcessFormatter{}, archive.Neg(), "-3 centuries -50 years -3 days -4 hours"},
+		{
+			"long/abbreviated",
+			ts.LongProcessFormatter{}.Option(ts.Abbreviated).(ts.BigFormatter),
+			archive,
+			"3c 50y 3d 4h",
+		},
+		{
+			"long/numbers",
+			ts.LongProcessFormatter{}.WithNumberFormat(ts.NumberEnglish),
+			million.Mul(1_000_000_000_000),
+			"10,000,000,000,000,000 centuries",
+		},
+		{
+			"long/units",
+			ts.LongProcessFormatter{}.WithUnits(ts.NewUnitSet(ts.UnitYear)),
+			million.Mul(1_000_000_000_000),
+			"1000000000000000000 years",
+		},
+		{
+			"long/spelled",
+			ts.LongProcessFormatter{}.WithSpelledNumbers(ts.EnglishSpeller{}),
+			archive,
+			"three centuries fifty years three days four hours",
+		},
+		{
+			"long/all-units",
+			ts.LongProcessFormatter{}.WithAllUnits(ts.UnitMinute),
+			archive,
+			"3 centuries 50 years 3 days 4 hours 0 minutes",
+		},
+		{"short/archive", ts.ShortProcessFormatter{}, archive.Add(ts.NewBigDuration(time.Millisecond)), "3c 50y 3d 4h 1ms"},
+		{"short/negative", ts.ShortProcessFormatter{}, archive.Neg(), "0s"},
+		{
+			"absolute/archive",
+			ts.AbsoluteFormatter{},
+			archive.Add(ts.NewBigDuration(time.Nanosecond)),
+			"3c 50y 3d 4h 1ns",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if out := tc.f.BigString(tc.bd); out != tc.ex {
+				t.Errorf("BigString(): expected(%s) got(%s)", tc.ex, out)
+			}
+		})
+	}
+
+	if out, ex := archive.String(), "3 centuries 50 years 3 days 4 hours"; out != ex {
+		t.Errorf("String(): expected(%s) got(%s)", ex, out)
+	}
+}
+
+func TestBigDuration_Parts(t *testing.T) {
+	t.Parallel()
+
+	bd := ts.BigDurationOf(big.NewInt(123_456), ts.UnitYear.Size).
+		Add(ts.NewBigDuration(2*24*time.Hour + 3*time.Hour + 4*time.Minute + 5*time.Second + 6789*time.Microsecond))
+
+	p := bd.Parts()
+	if p.Centuries.Cmp(big.NewInt(1234)) != 0 || p.Years != 56 || p.Days != 2 || p.Hours != 3 ||
+		p.Minutes != 4 || p.Seconds != 5 || p.Milliseconds != 6 || p.Microseconds != 789 || p.Nanoseconds != 0 {
+		t.Errorf("Parts(): unexpected result %+v", p)
+	}
+
+	n := bd.Neg().Parts()
+	if n.Centuries.Cmp(big.NewInt(-1234)) != 0 || n.Years != -56 || n.Microseconds != -789 {
+		t.Errorf("Parts(negative): unexpected result %+v", n)
+	}
+}
+
+func TestBigDuration_Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	hour := ts.NewBigDuration(time.Hour)
+	if td, ok := hour.Mul(3).Sub(hour).Duration(); !ok || td != 2*time.Hour {
+		t.Errorf("Duration(): expected(%s, true) got(%s, %t)", 2*time.Hour, td, ok)
+	}
+
+	huge := ts.BigDurationOf(big.NewInt(1000), ts.UnitYear.Size)
+	if _, ok := huge.Duration(); ok {
+		t.Errorf("Duration(): expected overflow for %s", huge)
+	}
+
+	if huge.Cmp(hour) != 1 || hour.Cmp(huge) != -1 || hour.Cmp(ts.NewBigDuration(time.Hour)) != 0 {
+		t.Errorf("Cmp(): unexpected ordering")
+	}
+
+	if (ts.BigDuration{}).Sign() != 0 || huge.Neg().Sign() != -1 {
+		t.Errorf("Sign(): unexpected sign")
+	}
+
+	ns := hour.Nanoseconds()
+	ns.SetInt64(0)
+
+	if hour.Sign() != 1 {
+		t.Errorf("Nanoseconds(): modifying the result changed the duration")
+	}
+}
+
+//nolint:paralleltest // modifies the exported unit sets.
+func TestBigDuration_PartsCustomUnits(t *testing.T) {
+	saved := ts.BigPreciseUnits
+	ts.BigPreciseUnits = ts.NewUnitSet(ts.UnitHour)
+
+	t.Cleanup(func() { ts.BigPreciseUnits = saved })
+
+	p := ts.NewBigDuration(26*time.Hour + 5*time.Second).Parts()
+	if p.Centuries.Sign() != 0 || p.Days != 1 || p.Hours != 2 || p.Seconds != 5 {
+		t.Errorf("Parts(): unexpected result %+v", p)
+	}
+}
@@ -0,0 +1,42 @@
+package timestring
+
+import "time"
+
+// Clock is the interface that provides the current time to the time-relative helpers.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock that returns the current system time using time.Now.
+//
+//nolint:gochecknoglobals // pre initialised clock.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// ClockFunc is an adapter to allow the use of ordinary functions as a Clock.
+type ClockFunc func() time.Time
+
+// Now calls f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// Since returns the time elapsed since t using the clock, like time.Since.
+// A nil clock uses SystemClock.
+func Since(clock Clock, t time.Time) time.Duration {
+	return clockOrDefault(clock).Now().Sub(t)
+}
+
+// Until returns the duration until t using the clock, like time.Until.
+// A nil clock uses SystemClock.
+func Until(clock Clock, t time.Time) time.Duration {
+	return t.Sub(clockOrDefault(clock).Now())
+}
+
+// clockOrDefault returns the clock, or SystemClock if it is nil.
+func clockOrDefault(c Clock) Clock {
+	if c == nil {
+		return SystemClock
+	}
+
+	return c
+}
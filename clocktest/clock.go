@@ -0,0 +1,51 @@
+// Package clocktest provides a controllable timestring.Clock for deterministic tests of the
+// time-relative helpers in timestring.
+package clocktest
+
+import (
+	"sync"
+	"time"
+
+	"github.com/na4ma4/go-timestring"
+)
+
+var _ timestring.Clock = (*Clock)(nil)
+
+// Clock is a fake timestring.Clock that only changes when it is advanced or set.
+//
+// It is safe for concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// New returns a fake Clock set to now.
+func New(now time.Time) *Clock {
+	return &Clock{now: now}
+}
+
+// Now returns the current time of the fake clock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Advance moves the fake clock forward by d (or backwards if d is negative) and returns the new time.
+func (c *Clock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	return c.now
+}
+
+// Set sets the fake clock to now.
+func (c *Clock) Set(now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = now
+}
@@ -0,0 +1,59 @@
+package clocktest_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/na4ma4/go-timestring"
+	"github.com/na4ma4/go-timestring/clocktest"
+)
+
+func TestClock(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	clock := clocktest.New(start)
+
+	if o := clock.Now(); !o.Equal(start) {
+		t.Errorf("Clock.Now() expected(%s) got(%s)", start, o)
+	}
+
+	if o := clock.Advance(90 * time.Minute); !o.Equal(start.Add(90 * time.Minute)) {
+		t.Errorf("Clock.Advance() expected(%s) got(%s)", start.Add(90*time.Minute), o)
+	}
+
+	if o := timestring.Since(clock, start); o != 90*time.Minute {
+		t.Errorf("Since() expected(%s) got(%s)", 90*time.Minute, o)
+	}
+
+	clock.Set(start.Add(-time.Hour))
+
+	if o := timestring.Until(clock, start); o != time.Hour {
+		t.Errorf("Until() expected(%s) got(%s)", time.Hour, o)
+	}
+}
+
+func TestClockDeadlineFormatter(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	clock := clocktest.New(start)
+	deadline := start.Add(2 * time.Hour)
+	f := timestring.NewDeadlineFormatter(timestring.ShortProcess).WithClock(clock)
+
+	if o := f.Format(deadline); o != "due in 2h" {
+		t.Errorf("DeadlineFormatter.Format() expected(due in 2h) got(%s)", o)
+	}
+
+	clock.Advance(2 * time.Hour)
+
+	if o := f.Format(deadline); o != "due now" {
+		t.Errorf("DeadlineFormatter.Format() expected(due now) got(%s)", o)
+	}
+
+	clock.Advance(49 * time.Hour)
+
+	if o := f.Format(deadline); o != "overdue by 2d 1h" {
+		t.Errorf("DeadlineFormatter.Format() expected(overdue by 2d 1h) got(%s)", o)
+	}
+}
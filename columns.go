@@ -0,0 +1,92 @@
+package timestring
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// FormatTable formats each duration using the formatter and returns the units of each duration
+// as a row of cells, with each unit in its own column (largest unit first) and an empty cell
+// where a duration does not display that unit.
+//
+// The cells can be joined with tabs and written to a text/tabwriter to line up the units.
+// Formatters that are not one of the standard formatters return a single cell per duration.
+func FormatTable(f Formatter, durations []time.Duration) [][]string {
+	rows := make([][]string, 0, len(durations))
+
+	ur, ok := f.(unitRenderer)
+	if !ok {
+		for _, td := range durations {
+			rows = append(rows, []string{f.String(td)})
+		}
+
+		return rows
+	}
+
+	visible := make([][]timeUnit, 0, len(durations))
+	columns := []Unit{}
+
+	for _, td := range durations {
+		units := ur.visibleUnits(td)
+		for _, unit := range units {
+			if !slices.Contains(columns, unit.unit) {
+				columns = append(columns, unit.unit)
+			}
+		}
+
+		visible = append(visible, units)
+	}
+
+	slices.SortStableFunc(columns, func(a, b Unit) int {
+		return cmp.Compare(b.Size, a.Size)
+	})
+
+	for _, units := range visible {
+		row := make([]string, len(columns))
+		for _, unit := range units {
+			row[slices.Index(columns, unit.unit)] = ur.renderUnit(unit)
+		}
+
+		rows = append(rows, row)
+	}
+
+	return rows
+}
+
+// FormatAligned formats each duration using the formatter and pads each unit so that the
+// units of every duration line up in columns, separated by a space.
+func FormatAligned(f Formatter, durations []time.Duration, align Alignment) []string {
+	rows := FormatTable(f, durations)
+	widths := []int{}
+
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+
+			widths[i] = max(widths[i], utf8.RuneCountInString(cell))
+		}
+	}
+
+	lines := make([]string, 0, len(rows))
+
+	for _, row := range rows {
+		cells := make([]string, len(row))
+		for i, cell := range row {
+			fill := strings.Repeat(" ", widths[i]-utf8.RuneCountInString(cell))
+			if align == AlignLeft {
+				cells[i] = cell + fill
+			} else {
+				cells[i] = fill + cell
+			}
+		}
+
+		lines = append(lines, strings.Join(cells, " "))
+	}
+
+	return lines
+}
@@ -0,0 +1,71 @@
+package timestring_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+type stringFormatter struct{}
+
+func (f stringFormatter) Option(...ts.FormatterOption) ts.Formatter { return f }
+
+func (stringFormatter) String(td time.Duration) string { return td.String() }
+
+func TestFormatTable(t *testing.T) {
+	t.Parallel()
+
+	durations := []time.Duration{26 * time.Hour, 13*24*time.Hour + 22*time.Hour + 5*time.Minute, 90 * time.Second}
+	rows := ts.FormatTable(ts.ShortProcess, durations)
+
+	expect := [][]string{
+		{"1d", "2h", "", ""},
+		{"13d", "22h", "5m", ""},
+		{"", "", "1m", "30s"},
+	}
+
+	if !slices.EqualFunc(rows, expect, slices.Equal) {
+		t.Errorf("FormatTable() expected(%q) got(%q)", expect, rows)
+	}
+
+	all := ts.LongProcessFormatter{}.WithAllUnits(ts.UnitMinute).Option(ts.Abbreviated)
+	rows = ts.FormatTable(all, []time.Duration{26 * time.Hour, 5 * time.Minute})
+
+	if expect := [][]string{{"1d", "2h", "0m"}, {"", "", "5m"}}; !slices.EqualFunc(rows, expect, slices.Equal) {
+		t.Errorf("FormatTable() with all units expected(%q) got(%q)", expect, rows)
+	}
+
+	rows = ts.FormatTable(stringFormatter{}, durations[:1])
+	if expect := [][]string{{"26h0m0s"}}; !slices.EqualFunc(rows, expect, slices.Equal) {
+		t.Errorf("FormatTable() with custom formatter expected(%q) got(%q)", expect, rows)
+	}
+}
+
+func TestFormatAligned(t *testing.T) {
+	t.Parallel()
+
+	durations := []time.Duration{26 * time.Hour, 13*24*time.Hour + 22*time.Hour, 3 * time.Hour}
+
+	tcs := []struct {
+		name  string
+		f     ts.Formatter
+		align ts.Alignment
+		ex    []string
+	}{
+		{"short/right", ts.ShortProcess, ts.AlignRight, []string{" 1d  2h", "13d 22h", "     3h"}},
+		{"short/left", ts.ShortProcess, ts.AlignLeft, []string{"1d  2h ", "13d 22h", "    3h "}},
+		{"long/right", ts.LongProcess, ts.AlignRight, []string{"  1 day  2 hours", "13 days 22 hours", "         3 hours"}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := ts.FormatAligned(tc.f, durations, tc.align); !slices.Equal(o, tc.ex) {
+				t.Errorf("FormatAligned() expected(%q) got(%q)", tc.ex, o)
+			}
+		})
+	}
+}
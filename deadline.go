@@ -0,0 +1,113 @@
+package timestring
+
+import (
+	"strings"
+	"time"
+)
+
+// DeadlinePhrases are the phrases used by the Deadline Formatter, "{duration}" is replaced by
+// the formatted time until (Future) or since (Past) the deadline.
+type DeadlinePhrases struct {
+	Future string // Used before the deadline (eg. "due in {duration}")
+	Now    string // Used at the deadline (eg. "due now")
+	Past   string // Used after the deadline (eg. "overdue by {duration}")
+}
+
+// DefaultDeadlinePhrases are the phrases used by the Deadline Formatter when none are supplied.
+//
+//nolint:gochecknoglobals // These are constants for phrases, not global state.
+var DefaultDeadlinePhrases = DeadlinePhrases{
+	Future: "due in {duration}",
+	Now:    "due now",
+	Past:   "overdue by {duration}",
+}
+
+// DeadlineFormatter is a Deadline Formatter.
+//
+// It displays the time remaining until a deadline ("due in 3h 20m"), or how long ago it passed
+// ("overdue by 2 days"), using another Formatter for the duration.
+//
+// The time remaining is rounded up to the resolution so that a deadline is never shown as due
+// before it has passed, and the time overdue is rounded down.
+type DeadlineFormatter struct {
+	formatter  Formatter
+	phrases    DeadlinePhrases
+	resolution time.Duration
+	clock      Clock
+}
+
+// NewDeadlineFormatter returns a Deadline Formatter that displays the duration using the supplied formatter.
+func NewDeadlineFormatter(f Formatter) DeadlineFormatter {
+	return DeadlineFormatter{formatter: f}
+}
+
+// WithPhrases returns a Deadline Formatter using the supplied phrases instead of
+// DefaultDeadlinePhrases, empty phrases use the default.
+func (d DeadlineFormatter) WithPhrases(phrases DeadlinePhrases) DeadlineFormatter {
+	d.phrases = phrases
+
+	return d
+}
+
+// WithResolution returns a Deadline Formatter that rounds the duration to the resolution instead
+// of a second (eg. time.Minute for "due in 3h 20m").
+func (d DeadlineFormatter) WithResolution(resolution time.Duration) DeadlineFormatter {
+	d.resolution = resolution
+
+	return d
+}
+
+// WithClock returns a Deadline Formatter that uses the supplied clock for the current time.
+func (d DeadlineFormatter) WithClock(clock Clock) DeadlineFormatter {
+	d.clock = clock
+
+	return d
+}
+
+// Format returns the time until or since the deadline relative to the current time of the clock.
+func (d DeadlineFormatter) Format(deadline time.Time) string {
+	return d.FormatRemaining(deadline.Sub(clockOrDefault(d.clock).Now()))
+}
+
+// FormatRemaining returns the phrase for the time remaining until a deadline, a negative
+// duration is the time since the deadline passed.
+func (d DeadlineFormatter) FormatRemaining(remaining time.Duration) string {
+	f := d.formatter
+	if f == nil {
+		f = ShortProcess
+	}
+
+	resolution := d.resolution
+	if resolution <= 0 {
+		resolution = time.Second
+	}
+
+	switch {
+	case remaining > 0:
+		ceil := remaining.Truncate(resolution)
+		if ceil < remaining && ceil+resolution > ceil {
+			ceil += resolution
+		}
+
+		return d.phrase(d.phrases.Future, DefaultDeadlinePhrases.Future, f.String(ceil))
+	case remaining > -resolution:
+		return d.phrase(d.phrases.Now, DefaultDeadlinePhrases.Now, "")
+	default:
+		overdue := -remaining
+		if overdue < 0 {
+			// -math.MinInt64 overflows.
+			overdue = -(remaining + resolution)
+		}
+
+		return d.phrase(d.phrases.Past, DefaultDeadlinePhrases.Past, f.String(overdue.Truncate(resolution)))
+	}
+}
+
+// phrase returns the phrase (or def if it is empty) with the duration inserted.
+func (d DeadlineFormatter) phrase(phrase, def, duration string) string {
+	if phrase == "" {
+		phrase = def
+	}
+
+	return strings.ReplaceAll(phrase, "{duration}", duration)
+}
@@ -0,0 +1,66 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestDeadlineFormatter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	clock := ts.ClockFunc(func() time.Time { return now })
+
+	tcs := []struct {
+		name     string
+		d        ts.DeadlineFormatter
+		deadline time.Time
+		ex       string
+	}{
+		{"future", ts.NewDeadlineFormatter(ts.ShortProcess), now.Add(3*time.Hour + 20*time.Minute), "due in 3h 20m"},
+		{
+			"future/ceil",
+			ts.NewDeadlineFormatter(ts.ShortProcess).WithResolution(time.Minute),
+			now.Add(3*time.Hour + 19*time.Minute + time.Second),
+			"due in 3h 20m",
+		},
+		{"future/ceil-second", ts.NewDeadlineFormatter(ts.ShortProcess), now.Add(time.Nanosecond), "due in 1s"},
+		{"now", ts.NewDeadlineFormatter(ts.ShortProcess), now, "due now"},
+		{"now/just-passed", ts.NewDeadlineFormatter(ts.ShortProcess), now.Add(-500 * time.Millisecond), "due now"},
+		{"past", ts.NewDeadlineFormatter(ts.LongProcess), now.Add(-49 * time.Hour), "overdue by 2 days 1 hour"},
+		{
+			"past/floor",
+			ts.NewDeadlineFormatter(ts.ShortProcess).WithResolution(time.Minute),
+			now.Add(-(2*time.Minute + 59*time.Second)),
+			"overdue by 2m",
+		},
+		{"default", ts.DeadlineFormatter{}, now.Add(time.Minute), "due in 1m"},
+		{
+			"phrases",
+			ts.NewDeadlineFormatter(ts.LongProcess).WithPhrases(ts.DeadlinePhrases{
+				Future: "{duration} left",
+				Past:   "late by {duration}",
+			}),
+			now.Add(time.Hour),
+			"1 hour left",
+		},
+		{
+			"phrases/default-now",
+			ts.NewDeadlineFormatter(ts.LongProcess).WithPhrases(ts.DeadlinePhrases{Past: "late by {duration}"}),
+			now,
+			"due now",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.d.WithClock(clock).Format(tc.deadline); o != tc.ex {
+				t.Errorf("DeadlineFormatter.Format(%s) expected(%s) got(%s)", tc.deadline, tc.ex, o)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package timestring
+
+import (
+	"math"
+	"time"
+)
+
+// Default signs used by the Delta Formatter.
+const (
+	DefaultDeltaPlus  = "+"
+	DefaultDeltaMinus = "−" // U+2212 MINUS SIGN
+)
+
+// DeltaWording is the wording used by the Delta Formatter to describe the relative change.
+//
+// When Increase or Decrease are empty the percentage is displayed with a sign instead (eg. "+12%").
+type DeltaWording struct {
+	Increase string // Used when the current duration is longer than the baseline (eg. "slower")
+	Decrease string // Used when the current duration is shorter than the baseline (eg. "faster")
+	Same     string // Used when the durations are equal (eg. "no change"), defaults to "0%"
+}
+
+// Predefined wordings for the Delta Formatter.
+//
+//nolint:gochecknoglobals // These are constants for wordings, not global state.
+var (
+	// DeltaSpeed describes run times, "12% slower", "5% faster".
+	DeltaSpeed = DeltaWording{Increase: "slower", Decrease: "faster", Same: "no change"}
+
+	// DeltaLength describes lengths of time, "12% longer", "5% shorter".
+	DeltaLength = DeltaWording{Increase: "longer", Decrease: "shorter", Same: "no change"}
+
+	// DeltaSigned describes the change with a signed percentage, "+12%", "−5%".
+	DeltaSigned = DeltaWording{}
+)
+
+// DeltaFormatter is a Delta Formatter.
+//
+// It displays the signed difference between a baseline and a current duration using another
+// Formatter (eg. "+2m 3s", "−450ms"), optionally followed by the relative change ("12% slower").
+type DeltaFormatter struct {
+	formatter Formatter
+	plus      string
+	minus     string
+	percent   bool
+	wording   DeltaWording
+	precision int
+	numbers   NumberFormatter
+}
+
+// NewDeltaFormatter returns a Delta Formatter that displays the difference using the supplied formatter.
+func NewDeltaFormatter(f Formatter) DeltaFormatter {
+	return DeltaFormatter{formatter: f}
+}
+
+// WithSigns returns a Delta Formatter that uses the supplied signs instead of DefaultDeltaPlus
+// and DefaultDeltaMinus (eg. "-" for an ASCII minus).
+func (d DeltaFormatter) WithSigns(plus, minus string) DeltaFormatter {
+	d.plus = plus
+	d.minus = minus
+
+	return d
+}
+
+// WithPercent returns a Delta Formatter that also displays the relative change from the baseline
+// as a percentage described by the wording (eg. "+2m 3s (12% slower)").
+func (d DeltaFormatter) WithPercent(wording DeltaWording) DeltaFormatter {
+	d.percent = true
+	d.wording = wording
+
+	return d
+}
+
+// WithPrecision returns a Delta Formatter that displays precision digits of the fractional part
+// of the percentage (eg. "12.5% slower"), the default of zero rounds to a whole number.
+func (d DeltaFormatter) WithPrecision(precision int) DeltaFormatter {
+	d.precision = precision
+
+	return d
+}
+
+// WithNumberFormat returns a Delta Formatter that formats the percentage using the supplied
+// number formatter, a nil formatter uses strconv.
+func (d DeltaFormatter) WithNumberFormat(nf NumberFormatter) DeltaFormatter {
+	d.numbers = nf
+
+	return d
+}
+
+// Delta returns the signed difference between the baseline and current durations.
+func (d DeltaFormatter) Delta(baseline, current time.Duration) string {
+	f := d.formatter
+	if f == nil {
+		f = ShortProcess
+	}
+
+	diff, negative := deltaMagnitude(baseline, current)
+
+	out := f.String(diff)
+	switch {
+	case diff == 0:
+	case negative:
+		out = d.sign(d.minus, DefaultDeltaMinus) + out
+	default:
+		out = d.sign(d.plus, DefaultDeltaPlus) + out
+	}
+
+	if d.percent && baseline != 0 {
+		out += " (" + d.Percent(baseline, current) + ")"
+	}
+
+	return out
+}
+
+// Percent returns the relative change from the baseline to the current duration described by
+// the wording of the Delta Formatter (eg. "12% slower"), a zero baseline returns an empty string.
+//
+//nolint:mnd // percentage.
+func (d DeltaFormatter) Percent(baseline, current time.Duration) string {
+	if baseline == 0 {
+		return ""
+	}
+
+	nf := d.numbers
+	if nf == nil {
+		nf = NumberFormat{}
+	}
+
+	change := (float64(current) - float64(baseline)) / math.Abs(float64(baseline)) * 100
+	pct := nf.FormatFloat(math.Abs(change), max(d.precision, 0)) + "%"
+
+	// Compare the rounded value so that a change that rounds to zero is the same.
+	if nf.FormatFloat(0, max(d.precision, 0))+"%" == pct {
+		if d.wording.Same != "" {
+			return d.wording.Same
+		}
+
+		return pct
+	}
+
+	switch {
+	case change > 0 && d.wording.Increase != "":
+		return pct + " " + d.wording.Increase
+	case change < 0 && d.wording.Decrease != "":
+		return pct + " " + d.wording.Decrease
+	case change > 0:
+		return d.sign(d.plus, DefaultDeltaPlus) + pct
+	default:
+		return d.sign(d.minus, DefaultDeltaMinus) + pct
+	}
+}
+
+// sign returns the sign, or def if it is not set.
+func (d DeltaFormatter) sign(sign, def string) string {
+	if sign == "" {
+		return def
+	}
+
+	return sign
+}
+
+// deltaMagnitude returns the magnitude of the difference between the baseline and current
+// durations and if it is negative, saturating at the maximum duration.
+func deltaMagnitude(baseline, current time.Duration) (time.Duration, bool) {
+	negative := current < baseline
+	if negative {
+		baseline, current = current, baseline
+	}
+
+	diff := current - baseline
+	if diff < 0 {
+		// The subtraction overflowed.
+		return math.MaxInt64, negative
+	}
+
+	return diff, negative
+}
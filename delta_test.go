@@ -0,0 +1,81 @@
+package timestring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestDeltaFormatter(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name              string
+		d                 ts.DeltaFormatter
+		baseline, current time.Duration
+		ex                string
+	}{
+		{"slower", ts.NewDeltaFormatter(ts.ShortProcess), 10 * time.Minute, 12*time.Minute + 3*time.Second, "+2m 3s"},
+		{"faster", ts.NewDeltaFormatter(ts.ShortProcess), time.Second, 550 * time.Millisecond, "−450ms"},
+		{"same", ts.NewDeltaFormatter(ts.ShortProcess), time.Second, time.Second, "0s"},
+		{"long", ts.NewDeltaFormatter(ts.LongProcess), time.Minute, 3 * time.Minute, "+2 minutes"},
+		{"default", ts.DeltaFormatter{}, time.Minute, 3 * time.Minute, "+2m"},
+		{"signs", ts.NewDeltaFormatter(ts.ShortProcess).WithSigns("+", "-"), 2 * time.Second, time.Second, "-1s"},
+		{
+			"percent/slower",
+			ts.NewDeltaFormatter(ts.ShortProcess).WithPercent(ts.DeltaSpeed),
+			100 * time.Second,
+			112 * time.Second,
+			"+12s (12% slower)",
+		},
+		{
+			"percent/faster",
+			ts.NewDeltaFormatter(ts.ShortProcess).WithPercent(ts.DeltaSpeed),
+			100 * time.Second,
+			95 * time.Second,
+			"−5s (5% faster)",
+		},
+		{
+			"percent/same",
+			ts.NewDeltaFormatter(ts.ShortProcess).WithPercent(ts.DeltaSpeed),
+			100 * time.Second,
+			100 * time.Second,
+			"0s (no change)",
+		},
+		{
+			"percent/signed",
+			ts.NewDeltaFormatter(ts.ShortProcess).WithPercent(ts.DeltaSigned).WithPrecision(1),
+			80 * time.Second,
+			70 * time.Second,
+			"−10s (−12.5%)",
+		},
+		{
+			"percent/numbers",
+			ts.NewDeltaFormatter(ts.ShortProcess).WithPercent(ts.DeltaLength).WithPrecision(1).
+				WithNumberFormat(ts.NumberEuropean),
+			80 * time.Second,
+			90 * time.Second,
+			"+10s (12,5% longer)",
+		},
+		{
+			"percent/zero-baseline",
+			ts.NewDeltaFormatter(ts.ShortProcess).WithPercent(ts.DeltaSpeed),
+			0,
+			time.Second,
+			"+1s",
+		},
+		{"overflow", ts.NewDeltaFormatter(ts.TotalSeconds), math.MinInt64, math.MaxInt64, "+9223372036s"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.d.Delta(tc.baseline, tc.current); o != tc.ex {
+				t.Errorf("DeltaFormatter.Delta(%s, %s) expected(%s) got(%s)", tc.baseline, tc.current, tc.ex, o)
+			}
+		})
+	}
+}
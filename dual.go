@@ -0,0 +1,61 @@
+package timestring
+
+import (
+	"strings"
+	"time"
+)
+
+// DefaultDualTemplate is the template used by the Dual Formatter when none is supplied.
+const DefaultDualTemplate = "{primary} ({secondary})"
+
+// DualFormatter is a Dual Formatter.
+//
+// It combines a primary (human readable) Formatter with a secondary (exact) Formatter,
+// like "2 hours 30 minutes (9000s)", for reports that need both representations.
+type DualFormatter struct {
+	primary   Formatter
+	secondary Formatter
+	template  string
+}
+
+// NewDualFormatter returns a Dual Formatter that displays the primary and secondary formatters
+// using DefaultDualTemplate.
+func NewDualFormatter(primary, secondary Formatter) DualFormatter {
+	return DualFormatter{primary: primary, secondary: secondary}
+}
+
+// Option returns a Dual Formatter with the options applied to the primary formatter.
+func (d DualFormatter) Option(opts ...FormatterOption) Formatter {
+	if d.primary != nil {
+		d.primary = d.primary.Option(opts...)
+	}
+
+	return d
+}
+
+// WithTemplate returns a Dual Formatter using the supplied template, "{primary}" and
+// "{secondary}" are replaced by the output of each formatter (eg. "{secondary} = {primary}").
+func (d DualFormatter) WithTemplate(template string) DualFormatter {
+	d.template = template
+
+	return d
+}
+
+// String returns the duration formatted by both formatters using the template.
+func (d DualFormatter) String(td time.Duration) string {
+	template := d.template
+	if template == "" {
+		template = DefaultDualTemplate
+	}
+
+	primary, secondary := "", ""
+	if d.primary != nil {
+		primary = d.primary.String(td)
+	}
+
+	if d.secondary != nil {
+		secondary = d.secondary.String(td)
+	}
+
+	return strings.NewReplacer("{primary}", primary, "{secondary}", secondary).Replace(template)
+}
@@ -0,0 +1,104 @@
+package timestring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestDualFormatter(t *testing.T) {
+	t.Parallel()
+
+	td := 2*time.Hour + 30*time.Minute
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		ex   string
+	}{
+		{"default", ts.NewDualFormatter(ts.LongProcess, ts.TotalSeconds), "2 hours 30 minutes (9000s)"},
+		{"short/iso", ts.NewDualFormatter(ts.ShortProcess, ts.ISO8601), "2h 30m (PT2H30M)"},
+		{
+			"template",
+			ts.NewDualFormatter(ts.ShortProcess, ts.ISO8601).WithTemplate("{secondary} = {primary}"),
+			"PT2H30M = 2h 30m",
+		},
+		{
+			"option",
+			ts.NewDualFormatter(ts.LongProcess, ts.TotalSeconds).Option(ts.Abbreviated, ts.NoSpaces),
+			"2h30m (9000s)",
+		},
+		{"nil", ts.NewDualFormatter(ts.ShortProcess, nil), "2h 30m ()"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", td, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestTotalFormatter(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		td   time.Duration
+		ex   string
+	}{
+		{"seconds", ts.TotalSeconds, 9000 * time.Second, "9000s"},
+		{"seconds/truncated", ts.TotalSeconds, 1500 * time.Millisecond, "1s"},
+		{"minutes", ts.NewTotalFormatter(ts.UnitMinute), 150 * time.Minute, "150 minutes"},
+		{"minutes/one", ts.NewTotalFormatter(ts.UnitMinute), time.Minute, "1 minute"},
+		{"minutes/nounitspaces", ts.NewTotalFormatter(ts.UnitMinute).Option(ts.NoUnitSpaces), time.Minute, "1minute"},
+		{"precision", ts.NewTotalFormatter(ts.UnitSecond).WithPrecision(1), 1500 * time.Millisecond, "1.5 seconds"},
+		{"precision/one", ts.NewTotalFormatter(ts.UnitSecond).WithPrecision(1), time.Second, "1.0 second"},
+		{
+			"numbers",
+			ts.NewTotalFormatter(ts.UnitSecond).WithNumberFormat(ts.NumberEnglish).Option(ts.Abbreviated),
+			9000 * time.Second,
+			"9,000s",
+		},
+		{"unset", ts.TotalFormatter{}, 2 * time.Second, "2 seconds"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(tc.td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestISO8601Formatter(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		td time.Duration
+		ex string
+	}{
+		{0, "PT0S"},
+		{2*time.Hour + 30*time.Minute, "PT2H30M"},
+		{49*time.Hour + 4*time.Minute + 5500*time.Millisecond, "P2DT1H4M5.5S"},
+		{48 * time.Hour, "P2D"},
+		{time.Nanosecond, "PT0.000000001S"},
+		{-90 * time.Second, "-PT1M30S"},
+		{math.MinInt64, "-P106751DT23H47M16.854775808S"},
+	}
+
+	for _, tc := range tcs {
+		if o := ts.ISO8601.String(tc.td); o != tc.ex {
+			t.Errorf("ISO8601.String(%s) expected(%s) got(%s)", tc.td, tc.ex, o)
+		}
+	}
+}
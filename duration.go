@@ -1,7 +1,9 @@
 package timestring
 
 import (
+	"fmt"
 	"math"
+	"math/big"
 	"time"
 )
 
@@ -42,3 +44,94 @@ func TimeDurationToDuration(td time.Duration) Duration {
 		Nanoseconds:  int64(math.Trunc(math.Mod(float64(td.Nanoseconds()), 1000))),
 	}
 }
+
+// durationFieldSizes are the sizes of the fields of Duration, these are fixed so that they are
+// not affected by changes to the exported unit sets.
+//
+//nolint:gochecknoglobals,mnd // lookup table.
+var durationFieldSizes = []time.Duration{
+	24 * time.Hour, time.Hour, time.Minute, time.Second, time.Millisecond, time.Microsecond, time.Nanosecond,
+}
+
+// durationFields returns pointers to the fields of the duration, in the same order as durationFieldSizes.
+func (d *Duration) durationFields() []*int64 {
+	return []*int64{
+		&d.Days, &d.Hours, &d.Minutes, &d.Seconds, &d.Milliseconds, &d.Microseconds, &d.Nanoseconds,
+	}
+}
+
+// BigDuration returns the total of all fields as a BigDuration, fields do not need to be normalised.
+func (d Duration) BigDuration() BigDuration {
+	ns := new(big.Int)
+	for i, field := range d.durationFields() {
+		ns.Add(ns, new(big.Int).Mul(big.NewInt(*field), big.NewInt(int64(durationFieldSizes[i]))))
+	}
+
+	return BigDuration{ns: ns}
+}
+
+// bigToDuration returns the number of nanoseconds as a normalised Duration, ErrDurationOverflow
+// is returned if the number of days does not fit in an int64.
+func bigToDuration(ns *big.Int) (Duration, error) {
+	var d Duration
+
+	values := splitBigSizes(ns, durationFieldSizes)
+	if !values[0].IsInt64() {
+		return Duration{}, fmt.Errorf("%w: %s days", ErrDurationOverflow, values[0])
+	}
+
+	for i, field := range d.durationFields() {
+		*field = values[i].Int64()
+	}
+
+	return d, nil
+}
+
+// Normalise returns the duration with each field carried into the next largest field, so that
+// non-normalised values (eg. 90 minutes or 30 hours) become 1 hour 30 minutes or 1 day 6 hours.
+//
+// Fields with mixed signs are combined (eg. 2 hours and -30 minutes is 1 hour 30 minutes),
+// the fields of a negative duration are all negative or zero.
+func (d Duration) Normalise() (Duration, error) {
+	return bigToDuration(d.BigDuration().value())
+}
+
+// Add returns the normalised sum of d and other.
+func (d Duration) Add(other Duration) (Duration, error) {
+	return bigToDuration(d.BigDuration().Add(other.BigDuration()).value())
+}
+
+// Sub returns the normalised difference of d and other.
+func (d Duration) Sub(other Duration) (Duration, error) {
+	return bigToDuration(d.BigDuration().Sub(other.BigDuration()).value())
+}
+
+// Compare compares the total of d and other and returns -1 if d is shorter, 0 if they are equal
+// and +1 if d is longer, fields do not need to be normalised.
+func (d Duration) Compare(other Duration) int {
+	return d.BigDuration().Cmp(other.BigDuration())
+}
+
+// IsZero returns true if the total of all fields is zero.
+func (d Duration) IsZero() bool {
+	return d.BigDuration().Sign() == 0
+}
+
+// TimeDuration returns the total of all fields as a time.Duration, ErrDurationOverflow is returned
+// if it does not fit.
+func (d Duration) TimeDuration() (time.Duration, error) {
+	bd := d.BigDuration()
+
+	td, ok := bd.Duration()
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrDurationOverflow, bd)
+	}
+
+	return td, nil
+}
+
+// FormatDuration returns the total of all fields of the Duration formatted using the formatter,
+// see FormatBigDuration for durations that do not fit in a time.Duration.
+func FormatDuration(f Formatter, d Duration) (string, error) {
+	return FormatBigDuration(f, d.BigDuration())
+}
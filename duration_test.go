@@ -0,0 +1,149 @@
+package timestring_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestDuration_Normalise(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		d    ts.Duration
+		ex   ts.Duration
+	}{
+		{"minutes", ts.Duration{Minutes: 90}, ts.Duration{Hours: 1, Minutes: 30}},
+		{"hours", ts.Duration{Hours: 30}, ts.Duration{Days: 1, Hours: 6}},
+		{"mixed-signs", ts.Duration{Hours: 2, Minutes: -30}, ts.Duration{Hours: 1, Minutes: 30}},
+		{"negative", ts.Duration{Minutes: -90}, ts.Duration{Hours: -1, Minutes: -30}},
+		{
+			"all",
+			ts.Duration{Seconds: 3661, Milliseconds: 1500, Microseconds: 2000, Nanoseconds: 1001},
+			ts.Duration{Hours: 1, Minutes: 1, Seconds: 2, Milliseconds: 502, Microseconds: 1, Nanoseconds: 1},
+		},
+		{"zero", ts.Duration{}, ts.Duration{}},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := tc.d.Normalise()
+			if err != nil {
+				t.Fatalf("Normalise(): unexpected error: %s", err)
+			}
+
+			if out != tc.ex {
+				t.Errorf("Normalise(): expected(%+v) got(%+v)", tc.ex, out)
+			}
+		})
+	}
+
+	if _, err := (ts.Duration{Days: math.MaxInt64, Hours: 24}).Normalise(); !errors.Is(err, ts.ErrDurationOverflow) {
+		t.Errorf("Normalise(): expected error(%s) got(%v)", ts.ErrDurationOverflow, err)
+	}
+}
+
+func TestDuration_Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	a := ts.Duration{Hours: 1, Minutes: 45}
+	b := ts.Duration{Minutes: 30}
+
+	if out, err := a.Add(b); err != nil || out != (ts.Duration{Hours: 2, Minutes: 15}) {
+		t.Errorf("Add(): expected(%+v) got(%+v, %v)", ts.Duration{Hours: 2, Minutes: 15}, out, err)
+	}
+
+	if out, err := b.Sub(a); err != nil || out != (ts.Duration{Hours: -1, Minutes: -15}) {
+		t.Errorf("Sub(): expected(%+v) got(%+v, %v)", ts.Duration{Hours: -1, Minutes: -15}, out, err)
+	}
+
+	if _, err := (ts.Duration{Days: math.MaxInt64}).Add(ts.Duration{Days: 1}); !errors.Is(err, ts.ErrDurationOverflow) {
+		t.Errorf("Add(): expected error(%s) got(%v)", ts.ErrDurationOverflow, err)
+	}
+
+	if out := (ts.Duration{Minutes: 90}).Compare(ts.Duration{Hours: 1, Minutes: 30}); out != 0 {
+		t.Errorf("Compare(): expected(0) got(%d)", out)
+	}
+
+	if out := a.Compare(b); out != 1 {
+		t.Errorf("Compare(): expected(1) got(%d)", out)
+	}
+
+	if out := b.Compare(a); out != -1 {
+		t.Errorf("Compare(): expected(-1) got(%d)", out)
+	}
+
+	if !(ts.Duration{Hours: 1, Minutes: -60}).IsZero() || a.IsZero() {
+		t.Errorf("IsZero(): unexpected result")
+	}
+}
+
+func TestDuration_TimeDuration(t *testing.T) {
+	t.Parallel()
+
+	td := 49*time.Hour + 3*time.Minute + 4*time.Second + 5*time.Millisecond
+	if out, err := ts.TimeDurationToDuration(td).TimeDuration(); err != nil || out != td {
+		t.Errorf("TimeDuration(): expected(%s) got(%s, %v)", td, out, err)
+	}
+
+	ex := 29*time.Hour + 45*time.Minute
+	if out, err := (ts.Duration{Hours: 30, Minutes: -15}).TimeDuration(); err != nil || out != ex {
+		t.Errorf("TimeDuration(): expected(%s) got(%s, %v)", ex, out, err)
+	}
+
+	if _, err := (ts.Duration{Days: 106752}).TimeDuration(); !errors.Is(err, ts.ErrDurationOverflow) {
+		t.Errorf("TimeDuration(): expected error(%s) got(%v)", ts.ErrDurationOverflow, err)
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		d    ts.Duration
+		ex   string
+		err  error
+	}{
+		{"long", ts.LongProcess, ts.Duration{Minutes: 90}, "1 hour 30 minutes", nil},
+		{"short", ts.ShortProcess, ts.Duration{Hours: 30, Seconds: 5}, "1d 6h 5s", nil},
+		{"absolute", ts.Absolute, ts.Duration{Microseconds: 1500}, "1ms 500µs", nil},
+		{"big", ts.LongProcess, ts.Duration{Days: 365 * 400}, "4 centuries", nil},
+		{"overflow", ts.TotalSeconds, ts.Duration{Days: 365 * 400}, "", ts.ErrDurationOverflow},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := ts.FormatDuration(tc.f, tc.d)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("FormatDuration(): expected error(%v) got(%v)", tc.err, err)
+			}
+
+			if out != tc.ex {
+				t.Errorf("FormatDuration(): expected(%s) got(%s)", tc.ex, out)
+			}
+		})
+	}
+}
+
+//nolint:paralleltest // modifies the exported unit sets.
+func TestDuration_NormaliseCustomUnits(t *testing.T) {
+	saved := ts.PreciseUnits
+	ts.PreciseUnits = ts.NewUnitSet(ts.UnitHour, ts.UnitMinute, ts.UnitSecond)
+
+	t.Cleanup(func() { ts.PreciseUnits = saved })
+
+	out, err := ts.Duration{Minutes: 90, Hours: 23}.Normalise()
+	if ex := (ts.Duration{Days: 1, Minutes: 30}); err != nil || out != ex {
+		t.Errorf("Normalise(): expected(%+v) got(%+v, %v)", ex, out, err)
+	}
+}
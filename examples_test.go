@@ -27,3 +27,13 @@ func ExampleShortProcess_String() {
 	fmt.Println(timestring.ShortProcess.String(d))
 	// Output: 2d 1h 15m 30s
 }
+
+// ExampleShortProcessFormatter_WithUnits demonstrates using a custom UnitSet with the ShortProcessFormatter.
+func ExampleShortProcessFormatter_WithUnits() {
+	minute := timestring.UnitMinute
+	minute.NameAbbrev = "min"
+
+	units := timestring.NewUnitSet(timestring.UnitWeek, timestring.UnitDay, timestring.UnitHour, minute)
+	fmt.Println(timestring.ShortProcessFormatter{}.WithUnits(units).String(192*time.Hour + 2*time.Minute))
+	// Output: 1w 1d 2min
+}
@@ -0,0 +1,86 @@
+package timestring
+
+import (
+	"flag"
+	"time"
+)
+
+// FlagValue is a flag.Value and flag.Getter for a time.Duration that accepts anything accepted
+// by Parse (eg. "2d", "1 day 3 hours" or "90s"), unlike flag.Duration.
+//
+// The duration is displayed using the formatter, so the default value in the output of -help
+// matches the style the flag accepts (eg. "(default 1d 3h)"). FlagDurationVar omits a zero default
+// like flag.DurationVar for any formatter, a FlagValue added with Var does so only with ShortProcess.
+type FlagValue struct {
+	p         *time.Duration
+	formatter Formatter
+}
+
+// NewFlagValue returns a FlagValue that stores the parsed duration in p, displayed using the
+// formatter (ShortProcess if nil).
+func NewFlagValue(p *time.Duration, f Formatter) *FlagValue {
+	return &FlagValue{p: p, formatter: f}
+}
+
+// Set implements flag.Value, the string is parsed using Parse.
+func (v *FlagValue) Set(s string) error {
+	td, err := Parse(s)
+	if err != nil {
+		return err
+	}
+
+	*v.p = td
+
+	return nil
+}
+
+// String implements flag.Value, the duration is displayed using the formatter.
+func (v *FlagValue) String() string {
+	f := ShortProcess
+	if v != nil && v.formatter != nil {
+		f = v.formatter
+	}
+
+	// The flag package calls String on a zero FlagValue to detect zero default values.
+	if v == nil || v.p == nil {
+		return f.String(0)
+	}
+
+	return f.String(*v.p)
+}
+
+// Get implements flag.Getter, it returns the time.Duration.
+func (v *FlagValue) Get() any {
+	if v == nil || v.p == nil {
+		return time.Duration(0)
+	}
+
+	return *v.p
+}
+
+// FlagDurationVar defines a duration flag with the name, default value and usage in the flag set
+// (flag.CommandLine if nil) like flag.DurationVar, the flag accepts anything accepted by Parse and
+// displays the default value using the formatter (ShortProcess if nil).
+func FlagDurationVar(fs *flag.FlagSet, f Formatter, p *time.Duration, name string, value time.Duration, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	*p = value
+	fs.Var(NewFlagValue(p, f), name, usage)
+
+	// Match the default of the zero FlagValue so that a zero default is omitted from the usage
+	// message like flag.DurationVar, whichever formatter is used.
+	if value == 0 {
+		fs.Lookup(name).DefValue = (&FlagValue{}).String()
+	}
+}
+
+// FlagDuration defines a duration flag like flag.Duration, see FlagDurationVar. The return value is
+// the address of a time.Duration variable that stores the value of the flag.
+func FlagDuration(fs *flag.FlagSet, f Formatter, name string, value time.Duration, usage string) *time.Duration {
+	p := new(time.Duration)
+	FlagDurationVar(fs, f, p, name, value, usage)
+
+	return p
+}
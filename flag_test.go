@@ -0,0 +1,99 @@
+package timestring_test
+
+import (
+	"bytes"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestFlagDuration(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		args []string
+		ex   time.Duration
+		err  string
+	}{
+		{[]string{}, 27 * time.Hour, ""},
+		{[]string{"-timeout", "2d"}, 48 * time.Hour, ""},
+		{[]string{"-timeout", "1 day 3 hours"}, 27 * time.Hour, ""},
+		{[]string{"-timeout=90s"}, 90 * time.Second, ""},
+		{[]string{"-timeout", "1h30m"}, 90 * time.Minute, ""},
+		{[]string{"-timeout", "soon"}, 0, `invalid value "soon" for flag -timeout: invalid duration`},
+	}
+
+	for _, tc := range tcs {
+		t.Run(strings.Join(tc.args, " "), func(t *testing.T) {
+			t.Parallel()
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(&bytes.Buffer{})
+
+			timeout := ts.FlagDuration(fs, nil, "timeout", 27*time.Hour, "request timeout")
+
+			err := fs.Parse(tc.args)
+			if (err == nil) != (tc.err == "") || (err != nil && !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("Parse(%q): expected error(%s) got(%v)", tc.args, tc.err, err)
+			}
+
+			if err == nil && *timeout != tc.ex {
+				t.Errorf("Parse(%q): expected(%s) got(%s)", tc.args, tc.ex, *timeout)
+			}
+		})
+	}
+}
+
+func TestFlagDurationVar_Defaults(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(buf)
+
+	var short, long, zero, longZero time.Duration
+
+	ts.FlagDurationVar(fs, nil, &short, "short", 27*time.Hour, "short `duration`")
+	ts.FlagDurationVar(fs, ts.LongProcess, &long, "long", 90*time.Minute, "long duration")
+	ts.FlagDurationVar(fs, nil, &zero, "zero", 0, "zero duration")
+	ts.FlagDurationVar(fs, ts.LongProcess, &longZero, "long-zero", 0, "long zero duration")
+	fs.PrintDefaults()
+
+	for _, ex := range []string{
+		"-short duration\n    \tshort duration (default 1d 3h)",
+		"-long value\n    \tlong duration (default 1 hour 30 minutes)",
+		"-zero value\n    \tzero duration\n",
+		"-long-zero value\n    \tlong zero duration\n",
+	} {
+		if !strings.Contains(buf.String(), ex) {
+			t.Errorf("PrintDefaults(): expected(%q) in output(%q)", ex, buf.String())
+		}
+	}
+}
+
+func TestFlagValue_Get(t *testing.T) {
+	t.Parallel()
+
+	var td time.Duration
+
+	v := ts.NewFlagValue(&td, ts.Absolute)
+	if err := v.Set("1.5ms"); err != nil {
+		t.Fatalf("Set(): unexpected error: %s", err)
+	}
+
+	var getter flag.Getter = v
+	if out, ok := getter.Get().(time.Duration); !ok || out != 1500*time.Microsecond {
+		t.Errorf("Get(): expected(%s) got(%v)", 1500*time.Microsecond, getter.Get())
+	}
+
+	if out, ex := v.String(), "1ms 500µs"; out != ex {
+		t.Errorf("String(): expected(%s) got(%s)", ex, out)
+	}
+
+	if out, ex := (&ts.FlagValue{}).String(), "0s"; out != ex {
+		t.Errorf("String(): expected(%s) got(%s)", ex, out)
+	}
+}
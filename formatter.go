@@ -10,6 +10,14 @@ type Formatter interface {
 	String(time.Duration) string
 }
 
+// unitRenderer is implemented by the standard formatters, it exposes the individual units
+// of a formatted duration so they can be arranged in columns or share units.
+type unitRenderer interface {
+	visibleUnits(td time.Duration) []timeUnit
+	renderUnit(unit timeUnit) string
+	renderNumber(unit timeUnit) string
+}
+
 // FormatterOption is a list of options that can be applied to the standard formatters.
 type FormatterOption uint
 
@@ -27,5 +35,6 @@ const (
 
 	// ShowMSOnSeconds is a FormatterOption that tells the formatter to show milliseconds when
 	// the value is less than a minute (59 seconds or less).
+	// See LongProcessFormatter.WithSubSeconds for smaller units and other thresholds.
 	ShowMSOnSeconds
 )
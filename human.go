@@ -0,0 +1,140 @@
+package timestring
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// HumanStyle chooses the formatter that a HumanOf duration is marshalled with, it is implemented
+// by empty struct types so that the style is part of the type of the field.
+type HumanStyle interface {
+	Formatter() Formatter
+}
+
+// Predefined styles for HumanOf durations.
+type (
+	// HumanShort marshals durations using ShortProcess (eg. "2h 30m").
+	HumanShort struct{}
+
+	// HumanLong marshals durations using LongProcess (eg. "2 hours 30 minutes").
+	HumanLong struct{}
+
+	// HumanAbsolute marshals durations using Absolute (eg. "2h 30m 1µs").
+	HumanAbsolute struct{}
+)
+
+// Formatter returns ShortProcess.
+func (HumanShort) Formatter() Formatter { return ShortProcess }
+
+// Formatter returns LongProcess.
+func (HumanLong) Formatter() Formatter { return LongProcess }
+
+// Formatter returns Absolute.
+func (HumanAbsolute) Formatter() Formatter { return Absolute }
+
+// HumanOf is a time.Duration that marshals to human readable text using the formatter of the
+// style S, for config and API structs (eg. `Timeout timestring.HumanOf[timestring.HumanLong]`).
+//
+// Marshalling is lossless, when the formatted text does not parse back to the same duration
+// (eg. negative or sub-millisecond durations with ShortProcess) the text of time.Duration.String
+// is used instead (eg. "-5s" or "1.5ms").
+//
+// It unmarshals from anything accepted by Parse (eg. "2 hours 30 minutes", "150m" or "2h30m")
+// and from plain integers of nanoseconds, as JSON numbers or text.
+type HumanOf[S HumanStyle] time.Duration
+
+// Human is a HumanOf duration that marshals using ShortProcess (eg. "2h 30m").
+type Human = HumanOf[HumanShort]
+
+// Duration returns the Human duration as a time.Duration.
+func (h HumanOf[S]) Duration() time.Duration {
+	return time.Duration(h)
+}
+
+// String returns the duration formatted using the formatter of the style, see HumanOf.
+func (h HumanOf[S]) String() string {
+	var style S
+
+	return formatLossless(style.Formatter(), time.Duration(h))
+}
+
+// MarshalText implements encoding.TextMarshaler, the duration is formatted as String.
+func (h HumanOf[S]) MarshalText() ([]byte, error) {
+	return []byte(h.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler, the text is parsed using Parse or as an
+// integer number of nanoseconds.
+func (h *HumanOf[S]) UnmarshalText(text []byte) error {
+	td, err := parseHuman(string(text))
+	if err != nil {
+		return err
+	}
+
+	*h = HumanOf[S](td)
+
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, the duration is formatted as a string as String.
+func (h HumanOf[S]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(h.String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler, it accepts a string parsed using Parse or an integer
+// number of nanoseconds, null leaves the duration unchanged.
+func (h *HumanOf[S]) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+
+	switch {
+	case bytes.Equal(data, []byte("null")):
+		return nil
+	case len(data) > 0 && data[0] == '"':
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return fmt.Errorf("unable to unmarshal duration: %w", err)
+		}
+
+		return h.UnmarshalText([]byte(s))
+	}
+
+	ns, err := strconv.ParseInt(string(data), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: %s is not a string or integer number of nanoseconds", ErrInvalidDuration, data)
+	}
+
+	*h = HumanOf[S](ns)
+
+	return nil
+}
+
+// formatLossless returns the duration formatted using the formatter, or time.Duration.String if
+// the formatted text does not parse back to the same duration.
+func formatLossless(f Formatter, td time.Duration) string {
+	if f != nil {
+		if out := f.String(td); parsesTo(out, td) {
+			return out
+		}
+	}
+
+	return td.String()
+}
+
+// parsesTo returns true if the string parses to the duration.
+func parsesTo(s string, td time.Duration) bool {
+	parsed, err := Parse(s)
+
+	return err == nil && parsed == td
+}
+
+// parseHuman parses the string as an integer number of nanoseconds or using Parse.
+func parseHuman(s string) (time.Duration, error) {
+	if ns, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(ns), nil
+	}
+
+	return Parse(s)
+}
@@ -0,0 +1,174 @@
+package timestring_test
+
+import (
+	"encoding/json"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestHuman_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	cfg := struct {
+		Timeout ts.Human  `json:"timeout"`
+		Retry   *ts.Human `json:"retry,omitempty"`
+	}{Timeout: ts.Human(2*time.Hour + 30*time.Minute)}
+
+	out, err := json.Marshal(cfg)
+	if err != nil {
+		t.Fatalf("json.Marshal(): unexpected error: %s", err)
+	}
+
+	if ex := `{"timeout":"2h 30m"}`; string(out) != ex {
+		t.Errorf("json.Marshal(): expected(%s) got(%s)", ex, out)
+	}
+}
+
+func TestHuman_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		in  string
+		ex  time.Duration
+		err error
+	}{
+		{`"2h 30m"`, 2*time.Hour + 30*time.Minute, nil},
+		{`"2 hours 30 minutes"`, 2*time.Hour + 30*time.Minute, nil},
+		{`"150m"`, 150 * time.Minute, nil},
+		{`"1h30m15.5s"`, time.Hour + 30*time.Minute + 15500*time.Millisecond, nil},
+		{`"1500"`, 1500, nil},
+		{`1500000000`, 1500 * time.Millisecond, nil},
+		{`-5`, -5, nil},
+		{`null`, time.Minute, nil},
+		{`1.5`, 0, ts.ErrInvalidDuration},
+		{`true`, 0, ts.ErrInvalidDuration},
+		{`"soon"`, 0, ts.ErrInvalidDuration},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.in, func(t *testing.T) {
+			t.Parallel()
+
+			h := ts.Human(time.Minute)
+
+			err := json.Unmarshal([]byte(tc.in), &h)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("json.Unmarshal(%s): expected error(%v) got(%v)", tc.in, tc.err, err)
+			}
+
+			if err == nil && h.Duration() != tc.ex {
+				t.Errorf("json.Unmarshal(%s): expected(%s) got(%s)", tc.in, tc.ex, h.Duration())
+			}
+		})
+	}
+}
+
+func TestHuman_Text(t *testing.T) {
+	t.Parallel()
+
+	h := ts.Human(26*time.Hour + 5*time.Second)
+
+	text, err := h.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(): unexpected error: %s", err)
+	}
+
+	if ex := "1d 2h 5s"; string(text) != ex {
+		t.Errorf("MarshalText(): expected(%s) got(%s)", ex, text)
+	}
+
+	var out ts.Human
+	if err := out.UnmarshalText(text); err != nil {
+		t.Fatalf("UnmarshalText(%s): unexpected error: %s", text, err)
+	}
+
+	if out != h {
+		t.Errorf("UnmarshalText(%s): expected(%s) got(%s)", text, h, out)
+	}
+
+	if err := out.UnmarshalText([]byte("1 fortnight")); !errors.Is(err, ts.ErrInvalidDuration) {
+		t.Errorf("UnmarshalText(): expected error(%s) got(%v)", ts.ErrInvalidDuration, err)
+	}
+
+	// Map keys use the text marshaler.
+	keys, err := json.Marshal(map[ts.Human]int{ts.Human(time.Minute): 1})
+	if err != nil {
+		t.Fatalf("json.Marshal(): unexpected error: %s", err)
+	}
+
+	if ex := `{"1m":1}`; string(keys) != ex {
+		t.Errorf("json.Marshal(): expected(%s) got(%s)", ex, keys)
+	}
+}
+
+func TestHuman_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	durations := []time.Duration{
+		0, time.Nanosecond, 1500 * time.Microsecond, 999 * time.Microsecond, 2*time.Hour + 30*time.Minute,
+		26*time.Hour + 5*time.Second + 7*time.Nanosecond, -5 * time.Second, -1500 * time.Microsecond,
+		-(26*time.Hour + 3*time.Millisecond), math.MaxInt64, math.MinInt64,
+	}
+
+	for _, td := range durations {
+		t.Run(td.String(), func(t *testing.T) {
+			t.Parallel()
+
+			roundTrip(t, ts.Human(td))
+			roundTrip(t, ts.HumanOf[ts.HumanLong](td))
+			roundTrip(t, ts.HumanOf[ts.HumanAbsolute](td))
+		})
+	}
+}
+
+// roundTrip marshals the value to JSON and text and checks that it unmarshals to the same value.
+func roundTrip[S ts.HumanStyle](t *testing.T, h ts.HumanOf[S]) {
+	t.Helper()
+
+	data, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("json.Marshal(): unexpected error: %s", err)
+	}
+
+	var out ts.HumanOf[S]
+	if err := json.Unmarshal(data, &out); err != nil || out != h {
+		t.Errorf("json.Unmarshal(%s): expected(%d) got(%d, %v)", data, h, out, err)
+	}
+
+	text, err := h.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText(): unexpected error: %s", err)
+	}
+
+	out = 0
+	if err := out.UnmarshalText(text); err != nil || out != h {
+		t.Errorf("UnmarshalText(%s): expected(%d) got(%d, %v)", text, h, out, err)
+	}
+}
+
+func TestHumanOf_String(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		out  string
+		ex   string
+	}{
+		{"short", ts.Human(90 * time.Minute).String(), "1h 30m"},
+		{"short/negative", ts.Human(-5 * time.Second).String(), "-5s"},
+		{"short/sub-millisecond", ts.Human(1500 * time.Microsecond).String(), "1.5ms"},
+		{"long", ts.HumanOf[ts.HumanLong](90 * time.Minute).String(), "1 hour 30 minutes"},
+		{"long/negative", ts.HumanOf[ts.HumanLong](-90 * time.Minute).String(), "-1h30m0s"},
+		{"absolute", ts.HumanOf[ts.HumanAbsolute](1500 * time.Microsecond).String(), "1ms 500µs"},
+	}
+
+	for _, tc := range tcs {
+		if tc.out != tc.ex {
+			t.Errorf("%s: String(): expected(%s) got(%s)", tc.name, tc.ex, tc.out)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package timestring
+
+import (
+	"strconv"
+	"time"
+)
+
+// IntervalUnits is the set of units that the Interval Formatter uses for frequency phrases.
+//
+//nolint:gochecknoglobals // These are constants for unit sets, not global state.
+var IntervalUnits = NewUnitSet(UnitWeek, UnitDay, UnitHour, UnitMinute)
+
+// IntervalFormatter is an Interval Formatter.
+//
+// It displays the interval of a schedule as a phrase using another Formatter for the body,
+// like "every 2 hours 30 minutes", "every hour" or "twice a day".
+type IntervalFormatter struct {
+	formatter Formatter
+	maxTimes  int
+}
+
+// NewIntervalFormatter returns an Interval Formatter that displays the interval using the supplied formatter.
+func NewIntervalFormatter(f Formatter) IntervalFormatter {
+	return IntervalFormatter{formatter: f}
+}
+
+// Option returns an Interval Formatter with the options applied to the body formatter.
+func (i IntervalFormatter) Option(opts ...FormatterOption) Formatter {
+	if i.formatter == nil {
+		i.formatter = LongProcess
+	}
+
+	i.formatter = i.formatter.Option(opts...)
+
+	return i
+}
+
+// WithFrequency returns an Interval Formatter that displays intervals that divide a week, day,
+// hour or minute evenly up to maxTimes as a frequency (eg. "twice a day", "3 times an hour").
+func (i IntervalFormatter) WithFrequency(maxTimes int) IntervalFormatter {
+	i.maxTimes = maxTimes
+
+	return i
+}
+
+// String returns the interval as a phrase, such as "every 15 minutes".
+//
+// A single unit with a value of one displays only the unit name (eg. "every hour").
+func (i IntervalFormatter) String(td time.Duration) string {
+	f := i.formatter
+	if f == nil {
+		f = LongProcess
+	}
+
+	if phrase, ok := i.frequency(td); ok {
+		return phrase
+	}
+
+	if ur, ok := f.(unitRenderer); ok {
+		if units := ur.visibleUnits(td); len(units) == 1 && units[0].value == 1 {
+			if out := f.String(td); out == ur.renderNumber(units[0])+" "+units[0].GetNameSingular() {
+				return "every " + units[0].GetNameSingular()
+			}
+		}
+	}
+
+	return "every " + f.String(td)
+}
+
+// frequency returns the interval as a frequency phrase if it divides one of the IntervalUnits
+// evenly between two and maxTimes times.
+func (i IntervalFormatter) frequency(td time.Duration) (string, bool) {
+	if i.maxTimes < 2 || td <= 0 { //nolint:mnd // at least twice.
+		return "", false
+	}
+
+	units := IntervalUnits.list()
+	for idx := len(units) - 1; idx >= 0; idx-- {
+		unit := units[idx]
+		if td >= unit.Size || unit.Size%td != 0 {
+			continue
+		}
+
+		times := int64(unit.Size / td)
+		if times > int64(i.maxTimes) {
+			continue
+		}
+
+		count := strconv.FormatInt(times, 10) + " times"
+		if times == 2 { //nolint:mnd // twice.
+			count = "twice"
+		}
+
+		return count + " " + englishArticle(unit.NameSingular) + " " + unit.NameSingular, true
+	}
+
+	return "", false
+}
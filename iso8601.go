@@ -0,0 +1,82 @@
+package timestring
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISO8601 is the ready-to-use ISO 8601 Formatter.
+//
+//nolint:gochecknoglobals // pre initialised formatter.
+var ISO8601 Formatter = ISO8601Formatter{}
+
+// ISO8601Formatter is an ISO 8601 Formatter.
+//
+// It displays the duration in the ISO 8601 duration format (eg. "P2DT3H4M5.5S"), which is
+// suitable for machines and the datetime attribute of HTML time elements.
+type ISO8601Formatter struct{}
+
+// Option returns an ISO 8601 Formatter, none of the options are applicable.
+func (i ISO8601Formatter) Option(...FormatterOption) Formatter {
+	return i
+}
+
+// String returns the duration in the ISO 8601 duration format, negative durations are
+// prefixed with a minus sign and a zero duration is "PT0S".
+//
+//nolint:mnd // These _are_ magic numbers.
+func (i ISO8601Formatter) String(td time.Duration) string {
+	if td == 0 {
+		return "PT0S"
+	}
+
+	sb := &strings.Builder{}
+
+	// Split using a negative duration so that math.MinInt64 does not overflow.
+	if td > 0 {
+		td = -td
+	} else {
+		sb.WriteString("-")
+	}
+
+	sb.WriteString("P")
+
+	days := -(td / (24 * time.Hour))
+	td %= 24 * time.Hour
+	hours := -(td / time.Hour)
+	td %= time.Hour
+	minutes := -(td / time.Minute)
+	td %= time.Minute
+	seconds := -(td / time.Second)
+	nanos := -(td % time.Second)
+
+	if days > 0 {
+		sb.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+	}
+
+	if hours == 0 && minutes == 0 && seconds == 0 && nanos == 0 {
+		return sb.String()
+	}
+
+	sb.WriteString("T")
+
+	if hours > 0 {
+		sb.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+
+	if minutes > 0 {
+		sb.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+
+	if seconds > 0 || nanos > 0 {
+		sb.WriteString(strconv.FormatInt(int64(seconds), 10))
+		if nanos > 0 {
+			sb.WriteString("." + strings.TrimRight(strconv.FormatInt(int64(nanos)+1e9, 10)[1:], "0"))
+		}
+
+		sb.WriteString("S")
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,133 @@
+package timestring
+
+import "strings"
+
+// ListStyle controls how the parts of a formatted duration are joined together.
+//
+// The zero value is an unset style, formatters fall back to joining parts with a space
+// (or nothing when NoSpaces is set).
+type ListStyle struct {
+	Separator     string // Placed between parts (eg. ", ")
+	LastSeparator string // Placed between the last two parts, defaults to Separator (eg. ", and ")
+	PairSeparator string // Placed between the parts when there are only two, defaults to LastSeparator (eg. " and ")
+}
+
+// Predefined list styles.
+//
+//nolint:gochecknoglobals // These are constants for list styles, not global state.
+var (
+	// ListSpaces joins parts with a single space, "1 hour 5 minutes 3 seconds".
+	ListSpaces = ListStyle{Separator: " "}
+
+	// ListColons joins parts with a colon, "1h:5m:3s".
+	ListColons = ListStyle{Separator: ":"}
+
+	// ListCommas joins parts with a comma, "1 hour, 5 minutes, 3 seconds".
+	ListCommas = ListStyle{Separator: ", "}
+
+	// ListEnglish joins parts with a comma and "and", "1 hour, 5 minutes and 3 seconds".
+	ListEnglish = NewConjunctionList(", ", "and", false)
+
+	// ListEnglishOxford joins parts with a comma and "and" using an Oxford comma,
+	// "1 hour, 5 minutes, and 3 seconds".
+	ListEnglishOxford = NewConjunctionList(", ", "and", true)
+)
+
+// localeConjunctions are the conjunctions used by ListStyleForLocale, keyed by language.
+//
+//nolint:gochecknoglobals // lookup table.
+var localeConjunctions = map[string]string{
+	"da": "og",
+	"de": "und",
+	"en": "and",
+	"es": "y",
+	"fi": "ja",
+	"fr": "et",
+	"it": "e",
+	"nb": "og",
+	"nl": "en",
+	"no": "og",
+	"pl": "i",
+	"pt": "e",
+	"sv": "och",
+}
+
+// NewConjunctionList returns a ListStyle that separates parts with separator and places
+// conjunction (eg. "and") before the last part.
+//
+// When oxford is true the separator is kept before the conjunction if there are more than two parts.
+func NewConjunctionList(separator, conjunction string, oxford bool) ListStyle {
+	pair := " " + conjunction + " "
+	ls := ListStyle{
+		Separator:     separator,
+		LastSeparator: pair,
+		PairSeparator: pair,
+	}
+
+	if oxford {
+		ls.LastSeparator = strings.TrimRight(separator, " ") + pair
+	}
+
+	return ls
+}
+
+// ListStyleForLocale returns a ListStyle using the conjunction for the language of the
+// supplied locale (eg. "en", "en-US", "fr_FR"), falling back to ListEnglish for unknown languages.
+//
+// American English ("en-US") uses an Oxford comma.
+func ListStyleForLocale(locale string) ListStyle {
+	locale = strings.ToLower(strings.ReplaceAll(locale, "_", "-"))
+	if locale == "en-us" {
+		return ListEnglishOxford
+	}
+
+	lang, _, _ := strings.Cut(locale, "-")
+	if conjunction, ok := localeConjunctions[lang]; ok {
+		return NewConjunctionList(", ", conjunction, false)
+	}
+
+	return ListEnglish
+}
+
+// IsZero returns true if the list style is unset.
+func (ls ListStyle) IsZero() bool {
+	return ls == ListStyle{}
+}
+
+// Join returns the parts joined using the list style.
+func (ls ListStyle) Join(parts []string) string {
+	last := ls.LastSeparator
+	if last == "" {
+		last = ls.Separator
+	}
+
+	pair := ls.PairSeparator
+	if pair == "" {
+		pair = last
+	}
+
+	switch len(parts) {
+	case 0:
+		return ""
+	case 1:
+		return parts[0]
+	case 2: //nolint:mnd // pair of parts.
+		return parts[0] + pair + parts[1]
+	}
+
+	return strings.Join(parts[:len(parts)-1], ls.Separator) + last + parts[len(parts)-1]
+}
+
+// joinParts joins the parts of a formatted duration using the list style, or spaces when
+// the style is unset (no separator when nospaces is set).
+func joinParts(parts []string, ls ListStyle, nospaces bool) string {
+	if !ls.IsZero() {
+		return ls.Join(parts)
+	}
+
+	if nospaces {
+		return strings.Join(parts, "")
+	}
+
+	return strings.Join(parts, " ")
+}
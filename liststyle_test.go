@@ -0,0 +1,75 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestListStyleJoin(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name  string
+		style ts.ListStyle
+		parts []string
+		ex    string
+	}{
+		{"empty", ts.ListEnglish, []string{}, ""},
+		{"single", ts.ListEnglish, []string{"1 hour"}, "1 hour"},
+		{"pair", ts.ListEnglish, []string{"1 hour", "5 minutes"}, "1 hour and 5 minutes"},
+		{"english", ts.ListEnglish, []string{"1 hour", "5 minutes", "3 seconds"}, "1 hour, 5 minutes and 3 seconds"},
+		{"oxford", ts.ListEnglishOxford, []string{"1 hour", "5 minutes", "3 seconds"}, "1 hour, 5 minutes, and 3 seconds"},
+		{"oxford/pair", ts.ListEnglishOxford, []string{"1 hour", "5 minutes"}, "1 hour and 5 minutes"},
+		{"colons", ts.ListColons, []string{"1h", "5m", "3s"}, "1h:5m:3s"},
+		{"commas", ts.ListCommas, []string{"1h", "5m"}, "1h, 5m"},
+		{"locale/fr", ts.ListStyleForLocale("fr_FR"), []string{"1", "2", "3"}, "1, 2 et 3"},
+		{"locale/de", ts.ListStyleForLocale("de"), []string{"1", "2"}, "1 und 2"},
+		{"locale/en-US", ts.ListStyleForLocale("en-US"), []string{"1", "2", "3"}, "1, 2, and 3"},
+		{"locale/unknown", ts.ListStyleForLocale("xx"), []string{"1", "2", "3"}, "1, 2 and 3"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.style.Join(tc.parts); o != tc.ex {
+				t.Errorf("ListStyle.Join(%q) expected(%s) got(%s)", tc.parts, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestListStyleFormatters(t *testing.T) {
+	t.Parallel()
+
+	td := time.Hour + 5*time.Minute + 3*time.Second
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		ex   string
+	}{
+		{"long/english", ts.LongProcessFormatter{}.WithListStyle(ts.ListEnglish), "1 hour, 5 minutes and 3 seconds"},
+		{"long/oxford", ts.LongProcessFormatter{}.WithListStyle(ts.ListEnglishOxford), "1 hour, 5 minutes, and 3 seconds"},
+		{
+			"long/nospaces-overridden",
+			ts.LongProcessFormatter{}.WithListStyle(ts.ListCommas).Option(ts.NoSpaces, ts.Abbreviated),
+			"1h, 5m, 3s",
+		},
+		{"short/colons", ts.ShortProcessFormatter{}.WithListStyle(ts.ListColons), "1h:5m:3s"},
+		{"absolute/english", ts.AbsoluteFormatter{}.WithListStyle(ts.ListEnglish), "1h, 5m and 3s"},
+		{"absolute/unset", ts.AbsoluteFormatter{}.WithListStyle(ts.ListStyle{}), "1h 5m 3s"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", td, tc.ex, o)
+			}
+		})
+	}
+}
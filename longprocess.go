@@ -1,7 +1,6 @@
 package timestring
 
 import (
-	"strings"
 	"time"
 )
 
@@ -19,6 +18,16 @@ type LongProcessFormatter struct {
 	nounitspaces bool
 	showmsonsec  bool
 	abbreviated  bool
+	units        UnitSet
+	list         ListStyle
+	pad          Padding
+	sentinels    Sentinels
+	numbers      NumberFormatter
+	speller      NumberSpeller
+	allunits     bool
+	smallest     Unit
+	subsmallest  Unit
+	subthreshold time.Duration
 }
 
 // Option returns a Long Process Formatter with the applied options.
@@ -39,49 +48,198 @@ func (a LongProcessFormatter) Option(opts ...FormatterOption) Formatter {
 	return a
 }
 
+// WithUnits returns a Long Process Formatter that splits durations into the supplied units
+// instead of StandardUnits.
+func (a LongProcessFormatter) WithUnits(units UnitSet) LongProcessFormatter {
+	a.units = units
+
+	return a
+}
+
+// WithListStyle returns a Long Process Formatter that joins the parts of the duration using
+// the supplied list style (eg. "1 hour, 5 minutes and 3 seconds"), this replaces NoSpaces.
+func (a LongProcessFormatter) WithListStyle(ls ListStyle) LongProcessFormatter {
+	a.list = ls
+
+	return a
+}
+
+// WithAllUnits returns a Long Process Formatter that displays every unit from the largest
+// non-zero unit down to the smallest unit, including zero values (eg. "2d 0h 15m 0s").
+//
+// Units smaller than smallest are not displayed, the smallest unit is displayed even when it
+// is normally only shown for durations under a minute. When no units are set with WithUnits and
+// smallest is smaller than a millisecond, the formatter uses PreciseUnits instead of StandardUnits.
+func (a LongProcessFormatter) WithAllUnits(smallest Unit) LongProcessFormatter {
+	a.allunits = true
+	a.smallest = smallest
+
+	return a
+}
+
+// WithPadding returns a Long Process Formatter that pads the value of each unit to a fixed width
+// (eg. "01d 02h" or " 1d  2h").
+func (a LongProcessFormatter) WithPadding(pad Padding) LongProcessFormatter {
+	a.pad = pad
+
+	return a
+}
+
+// WithSentinels returns a Long Process Formatter that displays the supplied strings in place of
+// zero, sub-resolution, maximum and minimum durations.
+func (a LongProcessFormatter) WithSentinels(sentinels Sentinels) LongProcessFormatter {
+	a.sentinels = sentinels
+
+	return a
+}
+
+// WithSpelledNumbers returns a Long Process Formatter that spells out the value of each unit
+// as words using the supplied speller (eg. "two hours five minutes"), a nil speller displays digits.
+//
+// Combine with a ListStyle for natural output, eg. "one day, three hours and an hour".
+func (a LongProcessFormatter) WithSpelledNumbers(speller NumberSpeller) LongProcessFormatter {
+	a.speller = speller
+
+	return a
+}
+
+// WithNumberFormat returns a Long Process Formatter that formats the value of each unit using the
+// supplied number formatter (eg. NumberEnglish for "12,345 days"), a nil formatter uses strconv.
+func (a LongProcessFormatter) WithNumberFormat(nf NumberFormatter) LongProcessFormatter {
+	a.numbers = nf
+
+	return a
+}
+
 // String returns a human readable string using the Long Process Formatter.
 // It formats the duration into days, hours, minutes, seconds, and milliseconds,
 // with options for abbreviated output, no spaces, and showing milliseconds on seconds.
 func (a LongProcessFormatter) String(td time.Duration) string {
-	d := TimeDurationToDuration(td)
-	units := []timeUnit{
-		unitDay.toTimeUnit(d.Days),
-		unitHour.toTimeUnit(d.Hours),
-		unitMinute.toTimeUnit(d.Minutes),
-		unitSecond.toTimeUnit(d.Seconds),
-		unitMillisecond.toTimeUnit(d.Milliseconds),
+	units := a.visibleUnits(td)
+	if str, ok := a.sentinels.replace(td, units); ok {
+		return str
+	}
+
+	parts := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		parts = append(parts, a.renderUnit(unit))
+	}
+
+	return joinParts(parts, a.list, a.nospaces)
+}
+
+// WithSubSeconds returns a Long Process Formatter that shows sub-second units down to smallest
+// (eg. UnitMicrosecond for "3 milliseconds 250 microseconds") when the duration is less than threshold.
+//
+// This replaces ShowMSOnSeconds, which is the same as WithSubSeconds(UnitMillisecond, time.Minute).
+// When no units are set with WithUnits, the formatter uses PreciseUnits instead of StandardUnits.
+func (a LongProcessFormatter) WithSubSeconds(smallest Unit, threshold time.Duration) LongProcessFormatter {
+	a.subsmallest = smallest
+	a.subthreshold = threshold
+
+	return a
+}
+
+// subSecondRange returns the size of the smallest sub-second unit to display and the threshold
+// the duration must be less than for sub-second units to be displayed, ok is false if sub-second
+// units are not displayed.
+func (a LongProcessFormatter) subSecondRange() (time.Duration, time.Duration, bool) {
+	if a.subthreshold > 0 {
+		return a.subsmallest.Size, a.subthreshold, true
+	}
+
+	if a.showmsonsec {
+		return time.Millisecond, time.Minute, true
+	}
+
+	return 0, 0, false
+}
+
+// renderUnit returns the string representation of a single unit.
+func (a LongProcessFormatter) renderUnit(unit timeUnit) string {
+	return a.pad.format(unit, a.renderNumber(unit), a.abbreviated, !a.nounitspaces)
+}
+
+// renderNumber returns the string representation of the value of a single unit.
+func (a LongProcessFormatter) renderNumber(unit timeUnit) string {
+	if a.speller != nil && unit.big == nil {
+		return a.speller.Spell(unit.value, unit.unit)
+	}
+
+	return formatUnitValue(a.numbers, unit)
+}
+
+// visibleUnits returns the units of the duration that should be displayed.
+func (a LongProcessFormatter) visibleUnits(td time.Duration) []timeUnit {
+	set := a.unitSet(StandardUnits, PreciseUnits)
+	if a.subthreshold > 0 {
+		set = a.units.orDefault(PreciseUnits)
+	}
+
+	units := set.timeUnits(td)
+	if a.allunits {
+		return a.allVisibleUnits(units)
 	}
 
-	var hasContent bool
-	o := &strings.Builder{}
+	subSmallest, subThreshold, subOK := a.subSecondRange()
+	visible := make([]timeUnit, 0, len(units))
 
 	for _, unit := range units {
-		// Skip ms if not showing ms on seconds or duration >= 60s
-		if unit.IsOnlyIfSeconds() && (!a.showmsonsec || td.Seconds() >= 60) {
+		// Skip sub-second units if not showing them, duration >= threshold or smaller than the smallest
+		if unit.IsOnlyIfSeconds() && (!subOK || td >= subThreshold || unit.unit.Size < subSmallest) {
 			continue
 		}
-		// Skip "0s" if showing ms and ms > 0
-		if unit.IsGlobalUnit(unitSecond) && unit.value == 0 && a.showmsonsec && d.Milliseconds > 0 {
+		// Skip "0s" (or "0ms") if showing sub-second units and a smaller one is > 0
+		if unit.value == 0 && subOK && unit.unit.Size > subSmallest && td%unit.unit.Size >= subSmallest {
 			continue
 		}
 		// Skip zero units unless showZero and no content yet
-		if unit.value == 0 && (!unit.IsShowZero() || hasContent) {
+		if unit.value == 0 && (!unit.IsShowZero() || len(visible) > 0) {
 			continue
 		}
 
-		if valStr := unit.String(a.abbreviated, !a.nounitspaces); len(valStr) > 0 {
-			o.WriteString(valStr)
-			if !a.nospaces {
-				o.WriteString(" ")
-			}
+		visible = append(visible, unit)
+	}
+
+	if len(visible) == 0 {
+		return []timeUnit{set.zeroUnit().toTimeUnit(0)}
+	}
+
+	return visible
+}
+
+// unitSet returns the units set with WithUnits, or def if none are set. When the smallest unit of
+// WithAllUnits is smaller than the units in def, precise is returned instead.
+func (a LongProcessFormatter) unitSet(def, precise UnitSet) UnitSet {
+	if smallest, ok := def.Smallest(); ok && a.allunits && a.smallest.Size < smallest.Size {
+		def = precise
+	}
+
+	return a.units.orDefault(def)
+}
 
-			hasContent = true
+// allVisibleUnits returns the units from the largest non-zero unit down to the smallest unit,
+// including zero values. Zero units larger than the largest non-zero unit are only displayed
+// when they are flagged with ShowZero (eg. "0s 500ms").
+func (a LongProcessFormatter) allVisibleUnits(units []timeUnit) []timeUnit {
+	visible := make([]timeUnit, 0, len(units))
+
+	for _, unit := range units {
+		if unit.unit.Size < a.smallest.Size {
+			break
 		}
+
+		if unit.value == 0 && len(visible) == 0 && !unit.IsShowZero() && unit.unit.Size > a.smallest.Size {
+			continue
+		}
+
+		visible = append(visible, unit)
 	}
 
-	if o.Len() == 0 {
-		return unitSecond.toTimeUnit(0).String(a.abbreviated, !a.nounitspaces)
+	if len(visible) == 0 {
+		return []timeUnit{a.smallest.toTimeUnit(0)}
 	}
 
-	return strings.TrimSpace(o.String())
+	return visible
 }
@@ -290,3 +290,94 @@ func TestLongProcessOptionTable(t *testing.T) {
 		})
 	}
 }
+
+func TestLongProcessAllUnitsTable(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		td       string
+		ex       string
+		smallest ts.Unit
+		opts     []ts.FormatterOption
+	}{
+		{"63h15m", "2d 15h 15m 0s", ts.UnitSecond, []ts.FormatterOption{ts.Abbreviated}},
+		{"48h15m", "2d 0h 15m 0s", ts.UnitSecond, []ts.FormatterOption{ts.Abbreviated}},
+		{"48h15m", "2 days 0 hours 15 minutes", ts.UnitMinute, []ts.FormatterOption{}},
+		{"15m30s", "15m 30s", ts.UnitSecond, []ts.FormatterOption{ts.Abbreviated}},
+		{"1h0m0.5s", "1h 0m 0s 500ms", ts.UnitMillisecond, []ts.FormatterOption{ts.Abbreviated}},
+		{"1h", "1h0m0s", ts.UnitSecond, []ts.FormatterOption{ts.Abbreviated, ts.NoSpaces}},
+		{"0s", "0s", ts.UnitSecond, []ts.FormatterOption{ts.Abbreviated}},
+		{"30s", "0 hours", ts.UnitHour, []ts.FormatterOption{}},
+		{"1.0015s", "1 second 1 millisecond 500 microseconds", ts.UnitMicrosecond, []ts.FormatterOption{}},
+		{"500ms", "0s 500ms", ts.UnitMillisecond, []ts.FormatterOption{ts.Abbreviated}},
+		{"25m", "25m 0s 0ms 0µs 0ns", ts.UnitNanosecond, []ts.FormatterOption{ts.Abbreviated}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.td+"/"+tc.smallest.NameAbbrev, func(t *testing.T) {
+			t.Parallel()
+
+			itd, err := time.ParseDuration(tc.td)
+			if err != nil {
+				t.Errorf("unexpected error parsing duration: %s", err)
+
+				return
+			}
+
+			f := ts.LongProcessFormatter{}.WithAllUnits(tc.smallest).Option(tc.opts...)
+			if o := f.String(itd); o != tc.ex {
+				t.Errorf("LongProcess.WithAllUnits(%s).String() returned invalid duration(%s): expected(%s) got(%s)",
+					tc.smallest.NameSingular,
+					itd.String(),
+					tc.ex,
+					o,
+				)
+			}
+		})
+	}
+}
+
+func TestLongProcessSubSecondsTable(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		td        string
+		ex        string
+		smallest  ts.Unit
+		threshold time.Duration
+		opts      []ts.FormatterOption
+	}{
+		{"3.25ms", "3 milliseconds 250 microseconds", ts.UnitMicrosecond, time.Minute, []ts.FormatterOption{}},
+		{"3.25ms", "3ms", ts.UnitMillisecond, time.Minute, []ts.FormatterOption{ts.Abbreviated}},
+		{"3.250012ms", "3ms 250µs 12ns", ts.UnitNanosecond, time.Minute, []ts.FormatterOption{ts.Abbreviated}},
+		{"1s3.25ms", "1 second 3 milliseconds 250 microseconds", ts.UnitMicrosecond, time.Minute, nil},
+		{"10s3ms", "10 seconds", ts.UnitMicrosecond, 10 * time.Second, []ts.FormatterOption{}},
+		{"9s3ms", "9 seconds 3 milliseconds", ts.UnitMicrosecond, 10 * time.Second, []ts.FormatterOption{}},
+		{"2m5s7ms", "2m 5s 7ms", ts.UnitMillisecond, time.Hour, []ts.FormatterOption{ts.Abbreviated}},
+		{"500ns", "500ns", ts.UnitNanosecond, time.Minute, []ts.FormatterOption{ts.Abbreviated}},
+		{"500ns", "0s", ts.UnitMicrosecond, time.Minute, []ts.FormatterOption{ts.Abbreviated}},
+		{"0s", "0 seconds", ts.UnitNanosecond, time.Minute, []ts.FormatterOption{}},
+	}
+	for _, tc := range tcs {
+		t.Run(tc.td+"/"+tc.smallest.NameAbbrev, func(t *testing.T) {
+			t.Parallel()
+
+			itd, err := time.ParseDuration(tc.td)
+			if err != nil {
+				t.Errorf("unexpected error parsing duration: %s", err)
+
+				return
+			}
+
+			f := ts.LongProcessFormatter{}.WithSubSeconds(tc.smallest, tc.threshold).Option(tc.opts...)
+			if o := f.String(itd); o != tc.ex {
+				t.Errorf("LongProcess.WithSubSeconds(%s, %s).String() returned invalid duration(%s): expected(%s) got(%s)",
+					tc.smallest.NameSingular,
+					tc.threshold,
+					itd.String(),
+					tc.ex,
+					o,
+				)
+			}
+		})
+	}
+}
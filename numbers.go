@@ -0,0 +1,163 @@
+package timestring
+
+import (
+	"strconv"
+	"strings"
+)
+
+// NumberFormatter is the interface that formats the numbers displayed by the formatters.
+type NumberFormatter interface {
+	FormatInt(n int64) string
+	FormatFloat(f float64, precision int) string
+}
+
+// Numerals are the ten digits (zero to nine) of a numeral system.
+type Numerals [10]rune
+
+// Predefined numeral systems.
+//
+//nolint:gochecknoglobals // These are constants for numeral systems, not global state.
+var (
+	// NumeralsLatin are the ASCII digits "0123456789".
+	NumeralsLatin = Numerals{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'}
+
+	// NumeralsArabicIndic are the Arabic-Indic digits "٠١٢٣٤٥٦٧٨٩".
+	NumeralsArabicIndic = Numerals{'٠', '١', '٢', '٣', '٤', '٥', '٦', '٧', '٨', '٩'}
+
+	// NumeralsDevanagari are the Devanagari digits "०१२३४५६७८९".
+	NumeralsDevanagari = Numerals{'०', '१', '२', '३', '४', '५', '६', '७', '८', '९'}
+
+	// NumeralsFullWidth are the full-width CJK digits "０１２３４５６７８９".
+	NumeralsFullWidth = Numerals{'０', '１', '２', '３', '４', '５', '６', '７', '８', '９'}
+)
+
+// NumberFormat is a NumberFormatter with configurable digit grouping, decimal separator and numerals.
+//
+// The zero value formats numbers the same as strconv (eg. "12345", "1.5").
+type NumberFormat struct {
+	GroupSeparator     string    // Placed between groups of digits (eg. "," for "12,345"), no grouping when empty
+	GroupSize          int       // Number of digits in the first group, defaults to 3
+	SecondaryGroupSize int       // Number of digits in the other groups, defaults to GroupSize (eg. 2 for "12,34,567")
+	DecimalSeparator   string    // Placed between the integer and fraction, defaults to "."
+	Numerals           *Numerals // Digits used to display the number, defaults to NumeralsLatin
+}
+
+// Predefined number formats.
+//
+//nolint:gochecknoglobals // These are constants for number formats, not global state.
+var (
+	// NumberEnglish groups thousands with commas, "12,345.6".
+	NumberEnglish = NumberFormat{GroupSeparator: ","}
+
+	// NumberEuropean groups thousands with periods and uses a decimal comma, "12.345,6".
+	NumberEuropean = NumberFormat{GroupSeparator: ".", DecimalSeparator: ","}
+
+	// NumberSI groups thousands with a narrow no-break space, "12 345.6".
+	NumberSI = NumberFormat{GroupSeparator: "\u202f"}
+
+	// NumberIndian groups with lakh and crore separators, "12,34,567.8".
+	NumberIndian = NumberFormat{GroupSeparator: ",", GroupSize: 3, SecondaryGroupSize: 2}
+
+	// NumberArabic uses Arabic-Indic digits and separators, "١٢٬٣٤٥٫٦".
+	NumberArabic = NumberFormat{GroupSeparator: "٬", DecimalSeparator: "٫", Numerals: &NumeralsArabicIndic}
+
+	// NumberDevanagari uses Devanagari digits with Indian grouping, "१२,३४,५६७".
+	NumberDevanagari = NumberFormat{
+		GroupSeparator: ",", GroupSize: 3, SecondaryGroupSize: 2, Numerals: &NumeralsDevanagari,
+	}
+
+	// NumberFullWidth uses full-width CJK digits, "１２３４５".
+	NumberFullWidth = NumberFormat{Numerals: &NumeralsFullWidth}
+)
+
+// FormatInt returns the integer formatted using the number format.
+func (nf NumberFormat) FormatInt(n int64) string {
+	return nf.format(strconv.FormatInt(n, 10))
+}
+
+// FormatFloat returns the float formatted using the number format with precision digits after
+// the decimal separator, a precision of -1 uses the smallest number of digits necessary.
+func (nf NumberFormat) FormatFloat(f float64, precision int) string {
+	return nf.format(strconv.FormatFloat(f, 'f', precision, 64))
+}
+
+// format returns a number formatted by strconv formatted using the number format.
+func (nf NumberFormat) format(num string) string {
+	sign := ""
+	if strings.HasPrefix(num, "-") {
+		sign, num = "-", num[1:]
+	}
+
+	integer, fraction, hasFraction := strings.Cut(num, ".")
+	integer = nf.group(integer)
+
+	decimal := nf.DecimalSeparator
+	if decimal == "" {
+		decimal = "."
+	}
+
+	if hasFraction {
+		num = integer + decimal + fraction
+	} else {
+		num = integer
+	}
+
+	return sign + nf.numerals(num)
+}
+
+// group returns the integer digits with the group separator inserted.
+//
+//nolint:mnd // default group size.
+func (nf NumberFormat) group(integer string) string {
+	if nf.GroupSeparator == "" {
+		return integer
+	}
+
+	size := nf.GroupSize
+	if size <= 0 {
+		size = 3
+	}
+
+	secondary := nf.SecondaryGroupSize
+	if secondary <= 0 {
+		secondary = size
+	}
+
+	if len(integer) <= size {
+		return integer
+	}
+
+	groups := []string{integer[len(integer)-size:]}
+	integer = integer[:len(integer)-size]
+
+	for len(integer) > secondary {
+		groups = append([]string{integer[len(integer)-secondary:]}, groups...)
+		integer = integer[:len(integer)-secondary]
+	}
+
+	return strings.Join(append([]string{integer}, groups...), nf.GroupSeparator)
+}
+
+// numerals returns the number with the ASCII digits replaced by the numerals of the number format.
+func (nf NumberFormat) numerals(num string) string {
+	if nf.Numerals == nil {
+		return num
+	}
+
+	return strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return nf.Numerals[r-'0']
+		}
+
+		return r
+	}, num)
+}
+
+// formatInt returns the integer formatted using the number formatter, or strconv if it is nil.
+func formatInt(nf NumberFormatter, n int64) string {
+	if nf == nil {
+		return strconv.FormatInt(n, 10)
+	}
+
+	return nf.FormatInt(n)
+}
@@ -0,0 +1,93 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestNumberFormatFormatInt(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		nf   ts.NumberFormat
+		n    int64
+		ex   string
+	}{
+		{"plain", ts.NumberFormat{}, 1234567, "1234567"},
+		{"english", ts.NumberEnglish, 1234567, "1,234,567"},
+		{"english/small", ts.NumberEnglish, 123, "123"},
+		{"english/negative", ts.NumberEnglish, -1234, "-1,234"},
+		{"european", ts.NumberEuropean, 1234567, "1.234.567"},
+		{"si", ts.NumberSI, 12345, "12\u202f345"},
+		{"indian", ts.NumberIndian, 1234567, "12,34,567"},
+		{"arabic", ts.NumberArabic, 12345, "١٢٬٣٤٥"},
+		{"devanagari", ts.NumberDevanagari, 1234567, "१२,३४,५६७"},
+		{"fullwidth", ts.NumberFullWidth, 2024, "２０２４"},
+	}
+
+	for _, tc := range tcs {
+		if o := tc.nf.FormatInt(tc.n); o != tc.ex {
+			t.Errorf("%s: NumberFormat.FormatInt(%d) expected(%s) got(%s)", tc.name, tc.n, tc.ex, o)
+		}
+	}
+}
+
+func TestNumberFormatFormatFloat(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name      string
+		nf        ts.NumberFormat
+		f         float64
+		precision int
+		ex        string
+	}{
+		{"plain", ts.NumberFormat{}, 12345.25, 1, "12345.2"},
+		{"shortest", ts.NumberFormat{}, 1.5, -1, "1.5"},
+		{"english", ts.NumberEnglish, 12345.6, 1, "12,345.6"},
+		{"european", ts.NumberEuropean, 12345.6, 2, "12.345,60"},
+		{"arabic", ts.NumberArabic, 12345.6, 1, "١٢٬٣٤٥٫٦"},
+		{"negative", ts.NumberEuropean, -1234.5, 1, "-1.234,5"},
+	}
+
+	for _, tc := range tcs {
+		if o := tc.nf.FormatFloat(tc.f, tc.precision); o != tc.ex {
+			t.Errorf("%s: NumberFormat.FormatFloat(%f) expected(%s) got(%s)", tc.name, tc.f, tc.ex, o)
+		}
+	}
+}
+
+func TestNumberFormatFormatters(t *testing.T) {
+	t.Parallel()
+
+	td := 12345*24*time.Hour + 3*time.Hour
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		ex   string
+	}{
+		{"long", ts.LongProcessFormatter{}.WithNumberFormat(ts.NumberEnglish), "12,345 days 3 hours"},
+		{"long/nil", ts.LongProcessFormatter{}.WithNumberFormat(nil), "12345 days 3 hours"},
+		{
+			"long/speller",
+			ts.LongProcessFormatter{}.WithNumberFormat(ts.NumberEnglish).WithSpelledNumbers(ts.EnglishSpeller{}),
+			"twelve thousand three hundred forty-five days three hours",
+		},
+		{"short", ts.ShortProcessFormatter{}.WithNumberFormat(ts.NumberFullWidth), "１２３４５d ３h"},
+		{"absolute", ts.AbsoluteFormatter{}.WithNumberFormat(ts.NumberArabic), "١٢٬٣٤٥d ٣h"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", td, tc.ex, o)
+			}
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package timestring
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+)
+
+// ErrNotANumber is returned when a numeric duration is NaN.
+var ErrNotANumber = errors.New("duration is not a number")
+
+// ErrInfiniteDuration is returned when a numeric duration is infinite.
+var ErrInfiniteDuration = errors.New("duration is infinite")
+
+// Number is the set of integer and floating point types accepted as numeric durations.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// DurationOf returns n units as a time.Duration (eg. DurationOf(1.5, time.Second) or
+// DurationOf(ms, time.Millisecond)), without converting to a time.Duration first.
+//
+// Floating point values are rounded to the nearest nanosecond, ErrNotANumber or ErrInfiniteDuration
+// is returned for NaN and infinite values and ErrDurationOverflow if the result does not fit.
+func DurationOf[N Number](n N, unit time.Duration) (time.Duration, error) {
+	bd, err := BigDurationOfNumber(n, unit)
+	if err != nil {
+		return 0, err
+	}
+
+	td, ok := bd.Duration()
+	if !ok {
+		return 0, fmt.Errorf("%w: %v × %s", ErrDurationOverflow, n, unit)
+	}
+
+	return td, nil
+}
+
+// BigDurationOfNumber returns n units as a BigDuration, see DurationOf.
+//
+// ErrNotANumber or ErrInfiniteDuration is returned for NaN and infinite values.
+func BigDurationOfNumber[N Number](n N, unit time.Duration) (BigDuration, error) {
+	var zero N
+
+	switch {
+	case N(1)/2 != zero: // floating point
+		f := float64(n)
+		if math.IsNaN(f) {
+			return BigDuration{}, ErrNotANumber
+		}
+
+		if math.IsInf(f, 0) {
+			return BigDuration{}, fmt.Errorf("%w: %v", ErrInfiniteDuration, f)
+		}
+
+		return BigDuration{ns: roundBigFloat(new(big.Float).SetPrec(bigFloatPrec).Mul(
+			new(big.Float).SetFloat64(f), new(big.Float).SetInt64(int64(unit)),
+		))}, nil
+	case zero-1 > zero: // unsigned integer
+		return BigDurationOf(new(big.Int).SetUint64(uint64(n)), unit), nil
+	default:
+		return BigDurationOf(big.NewInt(int64(n)), unit), nil
+	}
+}
+
+// FormatNumber returns n units formatted using the formatter (eg. FormatNumber(LongProcess, 90, time.Minute)
+// for "1 hour 30 minutes"), see DurationOf.
+//
+// Durations that do not fit in a time.Duration are displayed with BigString if the formatter is a
+// BigFormatter, otherwise ErrDurationOverflow is returned.
+func FormatNumber[N Number](f Formatter, n N, unit time.Duration) (string, error) {
+	bd, err := BigDurationOfNumber(n, unit)
+	if err != nil {
+		return "", err
+	}
+
+	return FormatBigDuration(f, bd)
+}
+
+// bigFloatPrec is the precision used to multiply floating point durations, enough for the product
+// of a float64 and an int64 to be exact.
+const bigFloatPrec = 128
+
+// roundBigFloat returns f rounded to the nearest integer, with halves rounded away from zero.
+func roundBigFloat(f *big.Float) *big.Int {
+	half := big.NewFloat(0.5) //nolint:mnd // half.
+	if f.Sign() < 0 {
+		half.Neg(half)
+	}
+
+	n, _ := new(big.Float).SetPrec(bigFloatPrec).Add(f, half).Int(nil)
+
+	return n
+}
@@ -0,0 +1,135 @@
+package timestring_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+type testSeconds float64
+
+// durationOf calls ts.DurationOf with the concrete type of n.
+func durationOf(t *testing.T, n any, unit time.Duration) (time.Duration, error) {
+	t.Helper()
+
+	switch n := n.(type) {
+	case int:
+		return ts.DurationOf(n, unit)
+	case int64:
+		return ts.DurationOf(n, unit)
+	case uint64:
+		return ts.DurationOf(n, unit)
+	case float32:
+		return ts.DurationOf(n, unit)
+	case float64:
+		return ts.DurationOf(n, unit)
+	case testSeconds:
+		return ts.DurationOf(n, unit)
+	}
+
+	t.Fatalf("unsupported type %T", n)
+
+	return 0, nil
+}
+
+// formatNumber calls ts.FormatNumber with the concrete type of n.
+func formatNumber(t *testing.T, f ts.Formatter, n any, unit time.Duration) (string, error) {
+	t.Helper()
+
+	switch n := n.(type) {
+	case int:
+		return ts.FormatNumber(f, n, unit)
+	case int64:
+		return ts.FormatNumber(f, n, unit)
+	case float64:
+		return ts.FormatNumber(f, n, unit)
+	}
+
+	t.Fatalf("unsupported type %T", n)
+
+	return "", nil
+}
+
+func TestDurationOf(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		n    any
+		unit time.Duration
+		ex   time.Duration
+		err  error
+	}{
+		{"float64/seconds", 1.5, time.Second, 1500 * time.Millisecond, nil},
+		{"float64/round", 0.1, time.Second, 100 * time.Millisecond, nil},
+		{"float64/round-half", 2.5, time.Nanosecond, 3, nil},
+		{"float64/negative", -2.25, time.Hour, -135 * time.Minute, nil},
+		{"float32", float32(0.5), time.Minute, 30 * time.Second, nil},
+		{"named", testSeconds(3), time.Second, 3 * time.Second, nil},
+		{"int64/ms", int64(90061001), time.Millisecond, 25*time.Hour + time.Minute + time.Second + time.Millisecond, nil},
+		{"int/negative", -5, time.Minute, -5 * time.Minute, nil},
+		{"uint64", uint64(42), time.Nanosecond, 42, nil},
+		{"int64/max", int64(math.MaxInt64), time.Nanosecond, math.MaxInt64, nil},
+		{"overflow/int", int64(math.MaxInt64), time.Millisecond, 0, ts.ErrDurationOverflow},
+		{"overflow/uint", uint64(math.MaxUint64), time.Nanosecond, 0, ts.ErrDurationOverflow},
+		{"overflow/float", 1e10, time.Second, 0, ts.ErrDurationOverflow},
+		{"nan", math.NaN(), time.Second, 0, ts.ErrNotANumber},
+		{"inf", math.Inf(1), time.Second, 0, ts.ErrInfiniteDuration},
+		{"-inf", math.Inf(-1), time.Second, 0, ts.ErrInfiniteDuration},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := durationOf(t, tc.n, tc.unit)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("DurationOf(%v): expected error(%v) got(%v)", tc.n, tc.err, err)
+			}
+
+			if out != tc.ex {
+				t.Errorf("DurationOf(%v): expected(%s) got(%s)", tc.n, tc.ex, out)
+			}
+		})
+	}
+}
+
+func TestFormatNumber(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		n    any
+		unit time.Duration
+		ex   string
+		err  error
+	}{
+		{"long/minutes", ts.LongProcess, 90, time.Minute, "1 hour 30 minutes", nil},
+		{"short/seconds", ts.ShortProcess, 3723.5, time.Second, "1h 2m 3s 500ms", nil},
+		{"absolute/ms", ts.Absolute, int64(1500), time.Millisecond, "1s 500ms", nil},
+		{"long/big", ts.LongProcess, int64(1000), ts.UnitYear.Size, "10 centuries", nil},
+		{"short/big-float", ts.ShortProcess, 1.5e12, time.Hour, "1712328c 76y 260d", nil},
+		{"not-big", ts.NewTotalFormatter(ts.UnitHour), 1e20, time.Second, "", ts.ErrDurationOverflow},
+		{"nan", ts.LongProcess, math.NaN(), time.Second, "", ts.ErrNotANumber},
+		{"inf", ts.LongProcess, math.Inf(1), time.Second, "", ts.ErrInfiniteDuration},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			out, err := formatNumber(t, tc.f, tc.n, tc.unit)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("FormatNumber(%v): expected error(%v) got(%v)", tc.n, tc.err, err)
+			}
+
+			if out != tc.ex {
+				t.Errorf("FormatNumber(%v): expected(%s) got(%s)", tc.n, tc.ex, out)
+			}
+		})
+	}
+}
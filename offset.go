@@ -0,0 +1,133 @@
+package timestring
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OffsetPhrases are the phrases used by the Offset Formatter, "{duration}" is replaced by the
+// formatted offset and "{reference}" by the name of the reference location.
+type OffsetPhrases struct {
+	Ahead  string // Used for positive offsets (eg. "{duration} ahead of {reference}")
+	Behind string // Used for negative offsets (eg. "{duration} behind {reference}")
+	Same   string // Used for a zero offset (eg. "same as {reference}")
+}
+
+// DefaultOffsetPhrases are the phrases used by the Offset Formatter when none are supplied.
+//
+//nolint:gochecknoglobals // These are constants for phrases, not global state.
+var DefaultOffsetPhrases = OffsetPhrases{
+	Ahead:  "{duration} ahead of {reference}",
+	Behind: "{duration} behind {reference}",
+	Same:   "same as {reference}",
+}
+
+// OffsetFormatter is an Offset Formatter.
+//
+// It displays time zone offsets using another Formatter, like "5 hours 30 minutes ahead of UTC"
+// with LongProcess or "5h 30m behind UTC" with ShortProcess.
+type OffsetFormatter struct {
+	formatter Formatter
+	phrases   OffsetPhrases
+}
+
+// NewOffsetFormatter returns an Offset Formatter that displays the offset using the supplied formatter.
+func NewOffsetFormatter(f Formatter) OffsetFormatter {
+	return OffsetFormatter{formatter: f}
+}
+
+// WithPhrases returns an Offset Formatter using the supplied phrases instead of
+// DefaultOffsetPhrases, empty phrases use the default.
+func (o OffsetFormatter) WithPhrases(phrases OffsetPhrases) OffsetFormatter {
+	o.phrases = phrases
+
+	return o
+}
+
+// Offset returns the offset in seconds east of UTC as a phrase (eg. "5 hours 30 minutes ahead of UTC").
+func (o OffsetFormatter) Offset(seconds int) string {
+	return o.phrase(time.Duration(seconds)*time.Second, "UTC")
+}
+
+// Location returns the offset of the location from UTC at the instant as a phrase.
+func (o OffsetFormatter) Location(loc *time.Location, at time.Time) string {
+	return o.Offset(LocationOffset(loc, at))
+}
+
+// Difference returns the difference between the locations at the instant as a phrase
+// (eg. "15 hours ahead of America/New_York").
+func (o OffsetFormatter) Difference(loc, reference *time.Location, at time.Time) string {
+	return o.phrase(LocationDifference(loc, reference, at), reference.String())
+}
+
+// phrase returns the phrase for the offset from the reference.
+func (o OffsetFormatter) phrase(offset time.Duration, reference string) string {
+	f := o.formatter
+	if f == nil {
+		f = LongProcess
+	}
+
+	var phrase string
+
+	switch {
+	case offset > 0:
+		phrase = cmp.Or(o.phrases.Ahead, DefaultOffsetPhrases.Ahead)
+	case offset < 0:
+		phrase = cmp.Or(o.phrases.Behind, DefaultOffsetPhrases.Behind)
+		offset = -offset
+	default:
+		phrase = cmp.Or(o.phrases.Same, DefaultOffsetPhrases.Same)
+	}
+
+	return strings.NewReplacer("{duration}", f.String(offset), "{reference}", reference).Replace(phrase)
+}
+
+// LocationOffset returns the offset in seconds east of UTC of the location at the instant.
+func LocationOffset(loc *time.Location, at time.Time) int {
+	_, offset := at.In(loc).Zone()
+
+	return offset
+}
+
+// LocationDifference returns how far ahead loc is of reference at the instant, the result is
+// negative if loc is behind reference.
+func LocationDifference(loc, reference *time.Location, at time.Time) time.Duration {
+	return time.Duration(LocationOffset(loc, at)-LocationOffset(reference, at)) * time.Second
+}
+
+// FormatOffset returns the offset in seconds east of UTC in ±HH:MM form (eg. "+05:30", "-03:00").
+// Seconds are included (±HH:MM:SS) only when the offset is not a whole number of minutes.
+//
+//nolint:mnd // These _are_ magic numbers.
+func FormatOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+
+	out := sign + twoDigits(seconds/3600) + ":" + twoDigits(seconds/60%60)
+	if seconds%60 != 0 {
+		out += ":" + twoDigits(seconds%60)
+	}
+
+	return out
+}
+
+// FormatLocationOffset returns the offset of the location from UTC at the instant in ±HH:MM form.
+func FormatLocationOffset(loc *time.Location, at time.Time) string {
+	return FormatOffset(LocationOffset(loc, at))
+}
+
+// twoDigits returns the number with a leading zero if it is a single digit.
+//
+//nolint:mnd // two digits.
+func twoDigits(n int) string {
+	if n < 10 {
+		return "0" + strconv.Itoa(n)
+	}
+
+	return strconv.Itoa(n)
+}
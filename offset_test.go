@@ -0,0 +1,124 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestOffsetFormatter_Offset(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name    string
+		o       ts.OffsetFormatter
+		seconds int
+		ex      string
+	}{
+		{"long/ahead", ts.NewOffsetFormatter(ts.LongProcess), 5*3600 + 30*60, "5 hours 30 minutes ahead of UTC"},
+		{"long/behind", ts.NewOffsetFormatter(ts.LongProcess), -3 * 3600, "3 hours behind UTC"},
+		{"short/ahead", ts.NewOffsetFormatter(ts.ShortProcess), 45 * 60, "45m ahead of UTC"},
+		{"short/behind", ts.NewOffsetFormatter(ts.ShortProcess), -(9*3600 + 30*60), "9h 30m behind UTC"},
+		{"same", ts.NewOffsetFormatter(ts.ShortProcess), 0, "same as UTC"},
+		{"default", ts.OffsetFormatter{}, 3600, "1 hour ahead of UTC"},
+		{
+			"phrases",
+			ts.NewOffsetFormatter(ts.ShortProcess).WithPhrases(ts.OffsetPhrases{Ahead: "UTC+{duration}"}),
+			2 * 3600,
+			"UTC+2h",
+		},
+		{
+			"phrases/default",
+			ts.NewOffsetFormatter(ts.ShortProcess).WithPhrases(ts.OffsetPhrases{Ahead: "UTC+{duration}"}),
+			-2 * 3600,
+			"2h behind UTC",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if out := tc.o.Offset(tc.seconds); out != tc.ex {
+				t.Errorf("Offset(%d): expected(%s) got(%s)", tc.seconds, tc.ex, out)
+			}
+		})
+	}
+}
+
+func TestOffsetFormatter_Location(t *testing.T) {
+	t.Parallel()
+
+	kolkata := time.FixedZone("IST", 5*3600+30*60)
+	newYork := time.FixedZone("America/New_York", -5*3600)
+	at := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	o := ts.NewOffsetFormatter(ts.LongProcess)
+
+	if out, ex := o.Location(kolkata, at), "5 hours 30 minutes ahead of UTC"; out != ex {
+		t.Errorf("Location(): expected(%s) got(%s)", ex, out)
+	}
+
+	if out, ex := o.Difference(kolkata, newYork, at), "10 hours 30 minutes ahead of America/New_York"; out != ex {
+		t.Errorf("Difference(): expected(%s) got(%s)", ex, out)
+	}
+
+	if out, ex := o.Difference(newYork, kolkata, at), "10 hours 30 minutes behind IST"; out != ex {
+		t.Errorf("Difference(): expected(%s) got(%s)", ex, out)
+	}
+
+	if out, ex := o.Difference(newYork, newYork, at), "same as America/New_York"; out != ex {
+		t.Errorf("Difference(): expected(%s) got(%s)", ex, out)
+	}
+}
+
+func TestLocationOffset_DaylightSaving(t *testing.T) {
+	t.Parallel()
+
+	loc, err := time.LoadLocation("Europe/London")
+	if err != nil {
+		t.Skipf("unable to load location: %s", err)
+	}
+
+	winter := time.Date(2026, time.January, 15, 12, 0, 0, 0, time.UTC)
+	summer := time.Date(2026, time.July, 15, 12, 0, 0, 0, time.UTC)
+
+	if out := ts.LocationOffset(loc, winter); out != 0 {
+		t.Errorf("LocationOffset(winter): expected(0) got(%d)", out)
+	}
+
+	if out := ts.LocationOffset(loc, summer); out != 3600 {
+		t.Errorf("LocationOffset(summer): expected(3600) got(%d)", out)
+	}
+
+	if out := ts.LocationDifference(loc, time.UTC, summer); out != time.Hour {
+		t.Errorf("LocationDifference(summer): expected(%s) got(%s)", time.Hour, out)
+	}
+}
+
+func TestFormatOffset(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		seconds int
+		ex      string
+	}{
+		{0, "+00:00"},
+		{5*3600 + 30*60, "+05:30"},
+		{-3 * 3600, "-03:00"},
+		{12*3600 + 45*60, "+12:45"},
+		{-(9*3600 + 30*60), "-09:30"},
+		{-(17*60 + 30), "-00:17:30"},
+	}
+
+	for _, tc := range tcs {
+		if out := ts.FormatOffset(tc.seconds); out != tc.ex {
+			t.Errorf("FormatOffset(%d): expected(%s) got(%s)", tc.seconds, tc.ex, out)
+		}
+	}
+
+	at := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	if out, ex := ts.FormatLocationOffset(time.FixedZone("", -4*3600), at), "-04:00"; out != ex {
+		t.Errorf("FormatLocationOffset(): expected(%s) got(%s)", ex, out)
+	}
+}
@@ -0,0 +1,61 @@
+package timestring
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// Alignment is the alignment of a padded unit value.
+type Alignment uint
+
+const (
+	// AlignRight pads before the value of each unit (eg. " 1d  2h" or "01d 02h").
+	AlignRight Alignment = iota
+
+	// AlignLeft pads after the unit name of each unit (eg. "1d  2h ").
+	AlignLeft
+)
+
+// Padding configures fixed-width output of each unit value so that formatted durations line up.
+//
+// The zero value disables padding.
+type Padding struct {
+	Width int       // Minimum width of the value of each unit
+	Char  rune      // Character used to pad the value with AlignRight, defaults to a space (use '0' for "01d")
+	Align Alignment // Alignment of the value, AlignLeft always pads with spaces
+}
+
+// Predefined paddings for two digit unit values.
+//
+//nolint:gochecknoglobals,mnd // These are constants for paddings, not global state.
+var (
+	// PadZero pads unit values to two digits with zeros, "01d 02h".
+	PadZero = Padding{Width: 2, Char: '0'}
+
+	// PadSpace pads unit values to two digits with spaces, " 1d  2h".
+	PadSpace = Padding{Width: 2, Char: ' '}
+)
+
+// format returns the string representation of the time unit with the formatted number padded.
+func (p Padding) format(unit timeUnit, num string, abbreviated, spaces bool) string {
+	fill := p.Width - utf8.RuneCountInString(num)
+
+	if fill <= 0 {
+		return unit.format(num, abbreviated, spaces)
+	}
+
+	if p.Align == AlignLeft {
+		return unit.format(num, abbreviated, spaces) + strings.Repeat(" ", fill)
+	}
+
+	char := p.Char
+	if char == 0 {
+		char = ' '
+	}
+
+	if char == '0' && strings.HasPrefix(num, "-") {
+		return unit.format("-"+strings.Repeat("0", fill)+num[1:], abbreviated, spaces)
+	}
+
+	return unit.format(strings.Repeat(string(char), fill)+num, abbreviated, spaces)
+}
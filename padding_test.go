@@ -0,0 +1,51 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestPaddingFormatters(t *testing.T) {
+	t.Parallel()
+
+	td := 26*time.Hour + 5*time.Second
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		td   time.Duration
+		ex   string
+	}{
+		{"short/zero", ts.ShortProcessFormatter{}.WithPadding(ts.PadZero), td, "01d 02h 05s"},
+		{"short/space", ts.ShortProcessFormatter{}.WithPadding(ts.PadSpace), td, " 1d  2h  5s"},
+		{"short/wide", ts.ShortProcessFormatter{}.WithPadding(ts.Padding{Width: 3, Char: '0'}), td, "001d 002h 005s"},
+		{"short/left", ts.ShortProcessFormatter{}.WithPadding(ts.Padding{Width: 2, Align: ts.AlignLeft}), td, "1d  2h  5s "},
+		{"short/fits", ts.ShortProcessFormatter{}.WithPadding(ts.PadZero), 13*24*time.Hour + 22*time.Hour, "13d 22h"},
+		{"absolute/zero", ts.AbsoluteFormatter{}.WithPadding(ts.PadZero), 0, "00s"},
+		{
+			"long/negative",
+			ts.LongProcessFormatter{}.WithPadding(ts.Padding{Width: 3, Char: '0'}).Option(ts.Abbreviated),
+			-time.Hour,
+			"-01h",
+		},
+		{"long/zero", ts.LongProcessFormatter{}.WithPadding(ts.PadZero), td, "01 day 02 hours 05 seconds"},
+		{
+			"long/allunits",
+			ts.LongProcessFormatter{}.WithPadding(ts.PadZero).WithAllUnits(ts.UnitSecond).Option(ts.Abbreviated),
+			td,
+			"01d 02h 00m 05s",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(tc.td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%q) got(%q)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package timestring
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// ErrInvalidDuration is returned when a string can not be parsed as a duration.
+var ErrInvalidDuration = errors.New("invalid duration")
+
+// ErrDurationOverflow is returned when a parsed or converted duration does not fit in a time.Duration.
+var ErrDurationOverflow = errors.New("duration overflows time.Duration")
+
+// ParseUnits is the set of units recognised by Parse.
+//
+//nolint:gochecknoglobals // These are constants for unit sets, not global state.
+var ParseUnits = NewUnitSet(
+	UnitWeek, UnitDay, UnitHour, UnitMinute, UnitSecond, UnitMillisecond, UnitMicrosecond, UnitNanosecond,
+)
+
+// parseAliases are the additional unit names recognised by Parse.
+//
+//nolint:gochecknoglobals // lookup table.
+var parseAliases = map[string]time.Duration{
+	"wk": 7 * 24 * time.Hour, "wks": 7 * 24 * time.Hour,
+	"hr": time.Hour, "hrs": time.Hour,
+	"min": time.Minute, "mins": time.Minute,
+	"sec": time.Second, "secs": time.Second,
+	"msec": time.Millisecond, "msecs": time.Millisecond,
+	"us": time.Microsecond, "μs": time.Microsecond, "usec": time.Microsecond, "usecs": time.Microsecond,
+	"nsec": time.Nanosecond, "nsecs": time.Nanosecond,
+}
+
+// Parse parses a human readable duration as displayed by the formatters of this package, such as
+// "2d 1h 15m 30s", "2 days, 1 hour and 15 minutes", "an hour" or "1h30m" (as time.ParseDuration).
+//
+// Unit names are not case sensitive and fractions are accepted (eg. "1.5h"), a leading "-" or "+"
+// sets the sign and a plain "0" is a zero duration. Each unit can only be used once and "and" must
+// be between two parts.
+func Parse(s string) (time.Duration, error) {
+	return parseDuration(s, ParseUnits, parseAliases)
+}
+
+// ParseWithUnits parses a human readable duration using only the names of the supplied units.
+func ParseWithUnits(s string, units UnitSet) (time.Duration, error) {
+	return parseDuration(s, units, nil)
+}
+
+// parseDuration parses a human readable duration using the names of the units and aliases.
+func parseDuration(s string, units UnitSet, aliases map[string]time.Duration) (time.Duration, error) {
+	in := strings.ToLower(strings.TrimSpace(s))
+
+	neg := false
+	switch {
+	case strings.HasPrefix(in, "-"):
+		neg, in = true, in[1:]
+	case strings.HasPrefix(in, "+"):
+		in = in[1:]
+	}
+
+	if in == "0" {
+		return 0, nil
+	}
+
+	var total time.Duration
+	var found, conjunction bool
+
+	seen := map[time.Duration]bool{}
+
+	rest := in
+	for {
+		rest = strings.TrimLeftFunc(rest, isParseSeparator)
+		if rest == "" {
+			break
+		}
+
+		num, word, remaining := nextParseToken(rest)
+		rest = remaining
+
+		switch {
+		case num == "" && word == "and":
+			if !found || conjunction {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+
+			conjunction = true
+
+			continue
+		case num == "" && (word == "a" || word == "an"):
+			num, word, rest = nextParseToken(strings.TrimLeftFunc(rest, isParseSeparator))
+			if num != "" {
+				return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+			}
+
+			num = "1"
+		case num == "" || word == "":
+			return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+		}
+
+		size, ok := lookupParseUnit(word, units, aliases)
+		if !ok {
+			return 0, fmt.Errorf("%w: unknown unit %q in %q", ErrInvalidDuration, word, s)
+		}
+
+		if seen[size] {
+			return 0, fmt.Errorf("%w: repeated unit %q in %q", ErrInvalidDuration, word, s)
+		}
+
+		seen[size] = true
+
+		var err error
+		if total, err = subtractParsed(total, num, size); err != nil {
+			return 0, fmt.Errorf("%w: %q", err, s)
+		}
+
+		found, conjunction = true, false
+	}
+
+	if !found || conjunction {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidDuration, s)
+	}
+
+	if neg {
+		return total, nil
+	}
+
+	if total == math.MinInt64 {
+		return 0, fmt.Errorf("%w: %q", ErrDurationOverflow, s)
+	}
+
+	return -total, nil
+}
+
+// subtractParsed subtracts num units of size from total, the total is accumulated as a negative
+// value and the magnitude of each part is parsed as unsigned so that math.MinInt64 can be parsed.
+func subtractParsed(total time.Duration, num string, size time.Duration) (time.Duration, error) {
+	integer, fraction, _ := strings.Cut(num, ".")
+
+	// The largest magnitude that can be subtracted from total (total - math.MinInt64), the
+	// addition wraps so that a zero total allows -math.MinInt64.
+	limit := uint64(total) + 1<<63 //nolint:gosec // total is never positive.
+
+	var value uint64
+	if integer != "" {
+		n, err := strconv.ParseUint(integer, 10, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return 0, ErrDurationOverflow
+			}
+
+			return 0, ErrInvalidDuration
+		}
+
+		if n > limit/uint64(size) {
+			return 0, ErrDurationOverflow
+		}
+
+		value = n * uint64(size)
+	}
+
+	if fraction != "" {
+		f, err := strconv.ParseFloat("0."+fraction, 64)
+		if err != nil {
+			return 0, ErrInvalidDuration
+		}
+
+		value += uint64(math.Round(f * float64(size)))
+	} else if integer == "" {
+		return 0, ErrInvalidDuration
+	}
+
+	if value > limit {
+		return 0, ErrDurationOverflow
+	}
+
+	return total - time.Duration(value), nil //nolint:gosec // value is at most -math.MinInt64, which wraps.
+}
+
+// isParseSeparator returns true for the runes that can separate the parts of a duration.
+func isParseSeparator(r rune) bool {
+	return unicode.IsSpace(r) || r == ',' || r == ':'
+}
+
+// nextParseToken returns the number and unit word at the start of s, and the remainder of s.
+func nextParseToken(s string) (string, string, string) {
+	end := strings.IndexFunc(s, func(r rune) bool {
+		return (r < '0' || r > '9') && r != '.'
+	})
+	if end < 0 {
+		return s, "", ""
+	}
+
+	num := s[:end]
+	rest := strings.TrimLeftFunc(s[end:], unicode.IsSpace)
+
+	end = strings.IndexFunc(rest, func(r rune) bool {
+		return !unicode.IsLetter(r) && r != 'µ'
+	})
+	if end < 0 {
+		end = len(rest)
+	}
+
+	return num, rest[:end], rest[end:]
+}
+
+// lookupParseUnit returns the size of the unit with the name (singular, plural or abbreviated).
+func lookupParseUnit(word string, units UnitSet, aliases map[string]time.Duration) (time.Duration, bool) {
+	for _, unit := range units.list() {
+		if word == strings.ToLower(unit.NameAbbrev) ||
+			word == strings.ToLower(unit.NameSingular) ||
+			word == strings.ToLower(unit.NamePlural) {
+			return unit.Size, true
+		}
+	}
+
+	size, ok := aliases[word]
+
+	return size, ok
+}
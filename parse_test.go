@@ -0,0 +1,105 @@
+package timestring_test
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		in string
+		ex time.Duration
+	}{
+		{"0", 0},
+		{"0s", 0},
+		{"2d 1h 15m 30s", 49*time.Hour + 15*time.Minute + 30*time.Second},
+		{"2d1h15m30s", 49*time.Hour + 15*time.Minute + 30*time.Second},
+		{"2 days 1 hour 15 minutes 30 seconds", 49*time.Hour + 15*time.Minute + 30*time.Second},
+		{"2days1hour", 49 * time.Hour},
+		{"1 hour, 5 minutes and 3 seconds", time.Hour + 5*time.Minute + 3*time.Second},
+		{"an hour", time.Hour},
+		{"a day and a minute", 24*time.Hour + time.Minute},
+		{"1h:5m", time.Hour + 5*time.Minute},
+		{"1h30m0.5s", time.Hour + 30*time.Minute + 500*time.Millisecond},
+		{"1.5h", 90 * time.Minute},
+		{"2 Weeks", 14 * 24 * time.Hour},
+		{"3 mins 2 secs", 3*time.Minute + 2*time.Second},
+		{"5s 500ms", 5*time.Second + 500*time.Millisecond},
+		{"100µs", 100 * time.Microsecond},
+		{"100us", 100 * time.Microsecond},
+		{"-90s", -90 * time.Second},
+		{"+90s", 90 * time.Second},
+		{"106751d 23h 47m 16s 854ms 775µs 807ns", math.MaxInt64},
+		{"-106751d 23h 47m 16s 854ms 775µs 808ns", math.MinInt64},
+		{"-9223372036854775808ns", math.MinInt64},
+		{"9223372036854775807ns", math.MaxInt64},
+	}
+
+	for _, tc := range tcs {
+		o, err := ts.Parse(tc.in)
+		if err != nil {
+			t.Errorf("Parse(%q) unexpected error: %s", tc.in, err)
+
+			continue
+		}
+
+		if o != tc.ex {
+			t.Errorf("Parse(%q) expected(%s) got(%s)", tc.in, tc.ex, o)
+		}
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		in  string
+		err error
+	}{
+		{"", ts.ErrInvalidDuration},
+		{"5", ts.ErrInvalidDuration},
+		{"hour", ts.ErrInvalidDuration},
+		{"5 fortnights", ts.ErrInvalidDuration},
+		{"a 5 hours", ts.ErrInvalidDuration},
+		{"1..5h", ts.ErrInvalidDuration},
+		{"106751d 23h 47m 16s 854ms 775µs 808ns", ts.ErrDurationOverflow},
+		{"300y", ts.ErrInvalidDuration},
+		{"999999999999999999999d", ts.ErrDurationOverflow},
+		{"200000d", ts.ErrDurationOverflow},
+		{"9223372036854775808ns", ts.ErrDurationOverflow},
+		{"-9223372036854775809ns", ts.ErrDurationOverflow},
+		{"-106751d 23h 47m 16s 854ms 775µs 809ns", ts.ErrDurationOverflow},
+		{"1 hour and", ts.ErrInvalidDuration},
+		{"and 1 hour", ts.ErrInvalidDuration},
+		{"1 hour and and 5 minutes", ts.ErrInvalidDuration},
+		{"1 hour 1 hour", ts.ErrInvalidDuration},
+		{"1h 30m 1hr", ts.ErrInvalidDuration},
+	}
+
+	for _, tc := range tcs {
+		if _, err := ts.Parse(tc.in); !errors.Is(err, tc.err) {
+			t.Errorf("Parse(%q) expected error(%v) got(%v)", tc.in, tc.err, err)
+		}
+	}
+}
+
+func TestParseWithUnits(t *testing.T) {
+	t.Parallel()
+
+	tick := ts.Unit{NameSingular: "tick", NamePlural: "ticks", NameAbbrev: "t", Size: 50 * time.Millisecond}
+	units := ts.NewUnitSet(ts.UnitSecond, tick)
+
+	if o, err := ts.ParseWithUnits("2s 3t", units); err != nil || o != 2150*time.Millisecond {
+		t.Errorf("ParseWithUnits(\"2s 3t\") expected(2.15s) got(%s, %v)", o, err)
+	}
+
+	if _, err := ts.ParseWithUnits("2m", units); !errors.Is(err, ts.ErrInvalidDuration) {
+		t.Errorf("ParseWithUnits(\"2m\") expected error(%v) got(%v)", ts.ErrInvalidDuration, err)
+	}
+}
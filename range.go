@@ -0,0 +1,190 @@
+package timestring
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Default separators used by the Range Formatter.
+const (
+	DefaultRangeDash = "–"
+	DefaultPlusMinus = "±"
+)
+
+// RangeFormatter is a Range Formatter.
+//
+// It displays ranges ("5–10 minutes", "1h 30m – 2h") and estimates ("2h ± 5m") of durations
+// using another Formatter, sharing the unit when both ends of a range display the same single unit.
+type RangeFormatter struct {
+	formatter Formatter
+	dash      string
+	plusminus string
+}
+
+// NewRangeFormatter returns a Range Formatter that displays durations using the supplied formatter.
+func NewRangeFormatter(f Formatter) RangeFormatter {
+	return RangeFormatter{formatter: f}
+}
+
+// WithDash returns a Range Formatter that separates the ends of a range with dash instead of
+// DefaultRangeDash (eg. "-" or " to ").
+func (r RangeFormatter) WithDash(dash string) RangeFormatter {
+	r.dash = dash
+
+	return r
+}
+
+// WithPlusMinus returns a Range Formatter that separates an estimate and its uncertainty with
+// plusminus instead of DefaultPlusMinus (eg. "+/-").
+func (r RangeFormatter) WithPlusMinus(plusminus string) RangeFormatter {
+	r.plusminus = plusminus
+
+	return r
+}
+
+// Range returns the range between lower and upper, the ends are swapped if lower is greater than upper.
+//
+// When both ends display the same single unit it is only displayed once (eg. "5–10m", "5–10 minutes"),
+// otherwise both ends are displayed in full (eg. "1h 30m – 2h").
+func (r RangeFormatter) Range(lower, upper time.Duration) string {
+	f := r.orDefault()
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+
+	if lower == upper {
+		return f.String(lower)
+	}
+
+	dash := r.dash
+	if dash == "" {
+		dash = DefaultRangeDash
+	}
+
+	if ur, ok := f.(unitRenderer); ok {
+		lu, uu := ur.visibleUnits(lower), ur.visibleUnits(upper)
+		if len(lu) == 1 && len(uu) == 1 && lu[0].unit == uu[0].unit && lu[0].value != 0 {
+			return ur.renderNumber(lu[0]) + dash + f.String(upper)
+		}
+	}
+
+	if strings.TrimSpace(dash) == dash {
+		dash = " " + dash + " "
+	}
+
+	return f.String(lower) + dash + f.String(upper)
+}
+
+// Estimate returns the estimate of value with the uncertainty (eg. "2h ± 5m").
+func (r RangeFormatter) Estimate(value, uncertainty time.Duration) string {
+	f := r.orDefault()
+
+	plusminus := r.plusminus
+	if plusminus == "" {
+		plusminus = DefaultPlusMinus
+	}
+
+	if uncertainty < 0 {
+		uncertainty = -uncertainty
+	}
+
+	return f.String(value) + " " + plusminus + " " + f.String(uncertainty)
+}
+
+// orDefault returns the formatter of the Range Formatter, or ShortProcess if it is not set.
+func (r RangeFormatter) orDefault() Formatter {
+	if r.formatter == nil {
+		return ShortProcess
+	}
+
+	return r.formatter
+}
+
+// ParseRange parses a range ("5–10 min", "1h 30m - 2h", "5 to 10 minutes") or an estimate
+// ("2h ± 5m", "2h +/- 5m") as displayed by the Range Formatter, returning the minimum and
+// maximum durations.
+//
+// When the lower end of a range is a plain number it uses the first unit of the upper end.
+func ParseRange(s string) (time.Duration, time.Duration, error) {
+	for _, sep := range []string{"±", "+/-", "+-"} {
+		if value, uncertainty, ok := strings.Cut(s, sep); ok {
+			return parseEstimate(s, value, uncertainty)
+		}
+	}
+
+	lowerStr, upperStr, ok := cutRange(s)
+	if !ok {
+		return 0, 0, fmt.Errorf("%w: not a range %q", ErrInvalidDuration, s)
+	}
+
+	upper, err := Parse(upperStr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lowerStr = strings.TrimSpace(lowerStr)
+	if _, numErr := strconv.ParseFloat(lowerStr, 64); numErr == nil {
+		_, word, _ := nextParseToken(strings.TrimSpace(upperStr))
+		lowerStr += " " + word
+	}
+
+	lower, err := Parse(lowerStr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if lower > upper {
+		lower, upper = upper, lower
+	}
+
+	return lower, upper, nil
+}
+
+// parseEstimate parses the value and uncertainty of an estimate, returning the minimum and maximum durations.
+func parseEstimate(s, valueStr, uncertaintyStr string) (time.Duration, time.Duration, error) {
+	value, err := Parse(valueStr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	uncertainty, err := Parse(uncertaintyStr)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if uncertainty < 0 {
+		uncertainty = -uncertainty
+	}
+
+	lower, upper := value-uncertainty, value+uncertainty
+	if lower > value || upper < value {
+		return 0, 0, fmt.Errorf("%w: %q", ErrDurationOverflow, s)
+	}
+
+	return lower, upper, nil
+}
+
+// cutRange splits a range at the dash (or "to") between the ends.
+func cutRange(s string) (string, string, bool) {
+	for _, sep := range []string{"–", "—", " to "} {
+		if lower, upper, ok := strings.Cut(s, sep); ok {
+			return lower, upper, true
+		}
+	}
+
+	// A hyphen can also be a leading minus sign, so only split after the first digit.
+	trimmed := strings.TrimSpace(s)
+	first := strings.IndexFunc(trimmed, unicode.IsDigit)
+	if first < 0 {
+		return "", "", false
+	}
+
+	if i := strings.Index(trimmed[first:], "-"); i >= 0 {
+		return trimmed[:first+i], trimmed[first+i+1:], true
+	}
+
+	return "", "", false
+}
@@ -0,0 +1,98 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestRangeFormatterRange(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name         string
+		r            ts.RangeFormatter
+		lower, upper time.Duration
+		ex           string
+	}{
+		{"short/shared", ts.NewRangeFormatter(ts.ShortProcess), 5 * time.Minute, 10 * time.Minute, "5–10m"},
+		{"long/shared", ts.NewRangeFormatter(ts.LongProcess), 5 * time.Minute, 10 * time.Minute, "5–10 minutes"},
+		{"long/one", ts.NewRangeFormatter(ts.LongProcess), time.Hour, 2 * time.Hour, "1–2 hours"},
+		{
+			"long/abbreviated",
+			ts.NewRangeFormatter(ts.LongProcess.Option(ts.Abbreviated)),
+			5 * time.Minute,
+			10 * time.Minute,
+			"5–10m",
+		},
+		{"short/mixed", ts.NewRangeFormatter(ts.ShortProcess), 90 * time.Minute, 2 * time.Hour, "1h 30m – 2h"},
+		{"short/units", ts.NewRangeFormatter(ts.ShortProcess), 30 * time.Second, 2 * time.Minute, "30s – 2m"},
+		{"short/swapped", ts.NewRangeFormatter(ts.ShortProcess), 10 * time.Minute, 5 * time.Minute, "5–10m"},
+		{"short/equal", ts.NewRangeFormatter(ts.ShortProcess), 5 * time.Minute, 5 * time.Minute, "5m"},
+		{"short/zero", ts.NewRangeFormatter(ts.ShortProcess), 0, 5 * time.Second, "0s – 5s"},
+		{"default", ts.RangeFormatter{}, 5 * time.Minute, 10 * time.Minute, "5–10m"},
+		{"dash", ts.NewRangeFormatter(ts.LongProcess).WithDash(" to "), 5 * time.Minute, 10 * time.Minute, "5 to 10 minutes"},
+		{"dash/mixed", ts.NewRangeFormatter(ts.ShortProcess).WithDash("-"), 90 * time.Minute, 2 * time.Hour, "1h 30m - 2h"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.r.Range(tc.lower, tc.upper); o != tc.ex {
+				t.Errorf("RangeFormatter.Range(%s, %s) expected(%s) got(%s)", tc.lower, tc.upper, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestRangeFormatterEstimate(t *testing.T) {
+	t.Parallel()
+
+	if o := ts.NewRangeFormatter(ts.ShortProcess).Estimate(2*time.Hour, 5*time.Minute); o != "2h ± 5m" {
+		t.Errorf("RangeFormatter.Estimate() expected(2h ± 5m) got(%s)", o)
+	}
+
+	r := ts.NewRangeFormatter(ts.LongProcess).WithPlusMinus("+/-")
+	if o := r.Estimate(2*time.Hour, -5*time.Minute); o != "2 hours +/- 5 minutes" {
+		t.Errorf("RangeFormatter.Estimate() expected(2 hours +/- 5 minutes) got(%s)", o)
+	}
+}
+
+func TestParseRange(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		in           string
+		lower, upper time.Duration
+	}{
+		{"5–10m", 5 * time.Minute, 10 * time.Minute},
+		{"5–10 minutes", 5 * time.Minute, 10 * time.Minute},
+		{"5-10 min", 5 * time.Minute, 10 * time.Minute},
+		{"5 to 10 minutes", 5 * time.Minute, 10 * time.Minute},
+		{"1h 30m – 2h", 90 * time.Minute, 2 * time.Hour},
+		{"2h - 1h 30m", 90 * time.Minute, 2 * time.Hour},
+		{"2h ± 5m", 115 * time.Minute, 125 * time.Minute},
+		{"2 hours +/- 5 minutes", 115 * time.Minute, 125 * time.Minute},
+	}
+
+	for _, tc := range tcs {
+		lower, upper, err := ts.ParseRange(tc.in)
+		if err != nil {
+			t.Errorf("ParseRange(%q) unexpected error: %s", tc.in, err)
+
+			continue
+		}
+
+		if lower != tc.lower || upper != tc.upper {
+			t.Errorf("ParseRange(%q) expected(%s, %s) got(%s, %s)", tc.in, tc.lower, tc.upper, lower, upper)
+		}
+	}
+
+	for _, in := range []string{"10 minutes", "5–10 fortnights", "x ± 5m", "2h ± y"} {
+		if _, _, err := ts.ParseRange(in); err == nil {
+			t.Errorf("ParseRange(%q) expected error", in)
+		}
+	}
+}
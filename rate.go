@@ -0,0 +1,167 @@
+package timestring
+
+import (
+	"cmp"
+	"math"
+	"time"
+)
+
+// RateUnits is the set of units that the Rate Formatter chooses the per-unit from.
+//
+//nolint:gochecknoglobals // These are constants for unit sets, not global state.
+var RateUnits = NewUnitSet(UnitDay, UnitHour, Unit{
+	NameSingular: "minute", NamePlural: "minutes", NameAbbrev: "min", Size: time.Minute,
+}, UnitSecond)
+
+// DefaultRateUndefined is displayed by the Rate Formatter when the rate is undefined, such as a
+// count over a zero or negative duration.
+const DefaultRateUndefined = "n/a"
+
+// rateSuffixes are the suffixes used by the Rate Formatter for compact numbers.
+//
+//nolint:gochecknoglobals // lookup table.
+var rateSuffixes = []string{"", "k", "M", "G", "T", "P", "E"}
+
+// RateFormatter is a Rate Formatter.
+//
+// It displays a count over a duration as a rate using the most readable unit from a UnitSet,
+// like "1.2k req/s", "3.2 ops/min" or "4 per hour".
+type RateFormatter struct {
+	noun      string
+	units     UnitSet
+	precision int
+	compact   bool
+	numbers   NumberFormatter
+	undefined string
+}
+
+// NewRateFormatter returns a Rate Formatter that displays rates of noun (eg. "req" for "12 req/s"),
+// an empty noun displays rates as "12 per second".
+func NewRateFormatter(noun string) RateFormatter {
+	return RateFormatter{noun: noun, precision: 1}
+}
+
+// WithUnits returns a Rate Formatter that chooses the per-unit from the supplied units instead of RateUnits.
+func (r RateFormatter) WithUnits(units UnitSet) RateFormatter {
+	r.units = units
+
+	return r
+}
+
+// WithPrecision returns a Rate Formatter that displays up to precision digits of the fractional
+// part of the rate, trailing zeros are not displayed.
+func (r RateFormatter) WithPrecision(precision int) RateFormatter {
+	r.precision = precision
+
+	return r
+}
+
+// WithCompact returns a Rate Formatter that abbreviates large rates with SI suffixes (eg. "1.2k req/s").
+func (r RateFormatter) WithCompact() RateFormatter {
+	r.compact = true
+
+	return r
+}
+
+// WithNumberFormat returns a Rate Formatter that formats the rate using the supplied
+// number formatter, a nil formatter uses strconv.
+func (r RateFormatter) WithNumberFormat(nf NumberFormatter) RateFormatter {
+	r.numbers = nf
+
+	return r
+}
+
+// WithUndefined returns a Rate Formatter that displays the supplied string instead of
+// DefaultRateUndefined when the rate is undefined (eg. "—" or "∞").
+func (r RateFormatter) WithUndefined(undefined string) RateFormatter {
+	r.undefined = undefined
+
+	return r
+}
+
+// Rate returns count over the duration as a rate, using the smallest unit that gives a rate
+// of at least one (eg. 4 over an hour is "4 per hour" rather than "0.1 per minute").
+//
+// A non-zero rate that rounds to zero in the largest unit is displayed as less than the smallest
+// value at the precision (eg. 1 over 30 days is "<0.1 per day").
+//
+// The rate is undefined when the duration is zero or negative, or the count is not finite, and
+// DefaultRateUndefined (or the string set with WithUndefined) is displayed instead.
+func (r RateFormatter) Rate(count float64, over time.Duration) string {
+	if over <= 0 || math.IsInf(count, 0) || math.IsNaN(count) {
+		return cmp.Or(r.undefined, DefaultRateUndefined)
+	}
+
+	units := r.units.orDefault(RateUnits).list()
+	unit := units[0]
+
+	perNano := count / float64(over)
+	for i := len(units) - 1; i >= 0; i-- {
+		if math.Abs(perNano*float64(units[i].Size)) >= 1 || i == 0 {
+			unit = units[i]
+
+			break
+		}
+	}
+
+	return r.format(perNano*float64(unit.Size), unit)
+}
+
+// format returns the rate per unit.
+//
+//nolint:mnd // These _are_ magic numbers.
+func (r RateFormatter) format(rate float64, unit Unit) string {
+	suffix := ""
+	if r.compact {
+		for i := 1; i < len(rateSuffixes) && math.Abs(rate) >= 1000; i++ {
+			rate /= 1000
+			suffix = rateSuffixes[i]
+		}
+	}
+
+	num := formatTrimmedFloat(r.numbers, rate, r.precision) + suffix
+	if rate != 0 && roundFloat(rate, max(r.precision, 0)) == 0 {
+		limit := formatTrimmedFloat(r.numbers, math.Pow(10, -float64(max(r.precision, 0))), r.precision)
+
+		num = "<" + limit
+		if rate < 0 {
+			num = ">-" + limit
+		}
+	}
+	if r.noun == "" {
+		return num + " per " + unit.NameSingular
+	}
+
+	return num + " " + r.noun + "/" + unit.NameAbbrev
+}
+
+// formatTrimmedFloat returns the float formatted using the number formatter with up to
+// precision digits of the fractional part, omitting trailing zeros.
+func formatTrimmedFloat(nf NumberFormatter, f float64, precision int) string {
+	if nf == nil {
+		nf = NumberFormat{}
+	}
+
+	precision = max(precision, 0)
+	if math.IsInf(f, 0) || math.IsNaN(f) {
+		return nf.FormatFloat(f, -1)
+	}
+
+	rounded := roundFloat(f, precision)
+	for p := range precision {
+		if roundFloat(f, p) == rounded {
+			return nf.FormatFloat(f, p)
+		}
+	}
+
+	return nf.FormatFloat(f, precision)
+}
+
+// roundFloat returns f rounded to precision digits of the fractional part.
+//
+//nolint:mnd // base 10.
+func roundFloat(f float64, precision int) float64 {
+	scale := math.Pow(10, float64(precision))
+
+	return math.Round(f*scale) / scale
+}
@@ -0,0 +1,90 @@
+package timestring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestRateFormatter(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name  string
+		r     ts.RateFormatter
+		count float64
+		over  time.Duration
+		ex    string
+	}{
+		{"per-second", ts.NewRateFormatter("req"), 1200, time.Second, "1200 req/s"},
+		{"compact", ts.NewRateFormatter("req").WithCompact(), 1200, time.Second, "1.2k req/s"},
+		{"compact/mega", ts.NewRateFormatter("req").WithCompact(), 3_400_000, time.Second, "3.4M req/s"},
+		{"per-minute", ts.NewRateFormatter("ops"), 32, 10 * time.Minute, "3.2 ops/min"},
+		{"per-hour", ts.NewRateFormatter(""), 4, time.Hour, "4 per hour"},
+		{"per-day", ts.NewRateFormatter(""), 3, 24 * time.Hour, "3 per day"},
+		{"slow", ts.NewRateFormatter(""), 1, 30 * 24 * time.Hour, "<0.1 per day"},
+		{"slow/negative", ts.NewRateFormatter("ops"), -1, 30 * 24 * time.Hour, ">-0.1 ops/d"},
+		{"slow/integer", ts.NewRateFormatter("").WithPrecision(0), 1, 30 * 24 * time.Hour, "<1 per day"},
+		{"slow/precision", ts.NewRateFormatter("").WithPrecision(2), 1, 30 * 24 * time.Hour, "0.03 per day"},
+		{"precision/trimmed", ts.NewRateFormatter("ops").WithPrecision(3), 30, 10 * time.Second, "3 ops/s"},
+		{"numbers", ts.NewRateFormatter("ops").WithNumberFormat(ts.NumberEuropean), 35, 10 * time.Second, "3,5 ops/s"},
+		{
+			"units",
+			ts.NewRateFormatter("").WithUnits(ts.NewUnitSet(ts.UnitWeek, ts.UnitDay)),
+			2,
+			7 * 24 * time.Hour,
+			"2 per week",
+		},
+		{"undefined/zero", ts.NewRateFormatter("req"), 5, 0, "n/a"},
+		{"undefined/negative", ts.NewRateFormatter(""), 5, -time.Second, "n/a"},
+		{"undefined/nan", ts.NewRateFormatter("req"), math.NaN(), time.Second, "n/a"},
+		{"undefined/inf", ts.NewRateFormatter("req"), math.Inf(1), time.Second, "n/a"},
+		{"undefined/custom", ts.NewRateFormatter("req").WithUndefined("—"), 5, 0, "—"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.r.Rate(tc.count, tc.over); o != tc.ex {
+				t.Errorf("RateFormatter.Rate(%f, %s) expected(%s) got(%s)", tc.count, tc.over, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestIntervalFormatter(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		i    ts.IntervalFormatter
+		td   time.Duration
+		ex   string
+	}{
+		{"long", ts.NewIntervalFormatter(ts.LongProcess), 150 * time.Minute, "every 2 hours 30 minutes"},
+		{"long/one", ts.NewIntervalFormatter(ts.LongProcess), time.Hour, "every hour"},
+		{"long/default", ts.IntervalFormatter{}, 15 * time.Minute, "every 15 minutes"},
+		{"short", ts.NewIntervalFormatter(ts.ShortProcess), 15 * time.Minute, "every 15m"},
+		{"short/one", ts.NewIntervalFormatter(ts.ShortProcess), time.Hour, "every 1h"},
+		{"option", ts.IntervalFormatter{}.Option(ts.Abbreviated).(ts.IntervalFormatter), time.Hour, "every 1h"},
+		{"twice", ts.NewIntervalFormatter(ts.LongProcess).WithFrequency(4), 12 * time.Hour, "twice a day"},
+		{"times", ts.NewIntervalFormatter(ts.LongProcess).WithFrequency(4), 20 * time.Minute, "3 times an hour"},
+		{"times/max", ts.NewIntervalFormatter(ts.LongProcess).WithFrequency(4), 6 * time.Hour, "4 times a day"},
+		{"times/over", ts.NewIntervalFormatter(ts.LongProcess).WithFrequency(3), 6 * time.Hour, "every 6 hours"},
+		{"times/uneven", ts.NewIntervalFormatter(ts.LongProcess).WithFrequency(4), 7 * time.Hour, "every 7 hours"},
+		{"times/week", ts.NewIntervalFormatter(ts.LongProcess).WithFrequency(4), 84 * time.Hour, "twice a week"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.i.String(tc.td); o != tc.ex {
+				t.Errorf("IntervalFormatter.String(%s) expected(%s) got(%s)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
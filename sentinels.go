@@ -0,0 +1,45 @@
+package timestring
+
+import (
+	"math"
+	"time"
+)
+
+// Sentinels configures the strings displayed in place of special durations.
+//
+// Empty fields keep the normal output of the formatter.
+type Sentinels struct {
+	Zero            string // Displayed for a zero duration (eg. "now", "—", "instant")
+	BelowResolution string // Displayed for a non-zero duration too small for any displayed unit (eg. "<1ms")
+	Max             string // Displayed for the maximum duration, math.MaxInt64 (eg. "∞", "forever")
+	Min             string // Displayed for the minimum duration, math.MinInt64 (eg. "-∞", "never")
+}
+
+// replace returns the sentinel string for the duration, units are the units that would be
+// displayed and are used to determine if the duration is below the resolution of the formatter.
+func (s Sentinels) replace(td time.Duration, units []timeUnit) (string, bool) {
+	switch {
+	case td == 0 && s.Zero != "":
+		return s.Zero, true
+	case td == math.MaxInt64 && s.Max != "":
+		return s.Max, true
+	case td == math.MinInt64 && s.Min != "":
+		return s.Min, true
+	case td != 0 && s.BelowResolution != "" && isBelowResolution(td, units):
+		return s.BelowResolution, true
+	}
+
+	return "", false
+}
+
+// isBelowResolution returns true if all the units have a value of zero and the duration is
+// smaller than the units.
+func isBelowResolution(td time.Duration, units []timeUnit) bool {
+	for _, unit := range units {
+		if unit.value != 0 || td >= unit.unit.Size || td <= -unit.unit.Size {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,52 @@
+package timestring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestSentinelsFormatters(t *testing.T) {
+	t.Parallel()
+
+	sentinels := ts.Sentinels{Zero: "now", BelowResolution: "<1ms", Max: "∞", Min: "never"}
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		td   time.Duration
+		ex   string
+	}{
+		{"long/zero", ts.LongProcessFormatter{}.WithSentinels(sentinels), 0, "now"},
+		{"long/below", ts.LongProcessFormatter{}.WithSentinels(sentinels), 500 * time.Millisecond, "<1ms"},
+		{"long/max", ts.LongProcessFormatter{}.WithSentinels(sentinels), math.MaxInt64, "∞"},
+		{"long/min", ts.LongProcessFormatter{}.WithSentinels(sentinels), math.MinInt64, "never"},
+		{"long/normal", ts.LongProcessFormatter{}.WithSentinels(sentinels), time.Minute, "1 minute"},
+		{"long/unset", ts.LongProcessFormatter{}.WithSentinels(ts.Sentinels{Max: "∞"}), 0, "0 seconds"},
+		{"short/zero", ts.ShortProcessFormatter{}.WithSentinels(sentinels), 0, "now"},
+		{"short/below", ts.ShortProcessFormatter{}.WithSentinels(sentinels), 100 * time.Microsecond, "<1ms"},
+		{"short/ms", ts.ShortProcessFormatter{}.WithSentinels(sentinels), time.Millisecond, "1ms"},
+		{"short/negative", ts.ShortProcessFormatter{}.WithSentinels(sentinels), -5 * time.Second, "0s"},
+		{"absolute/zero", ts.AbsoluteFormatter{}.WithSentinels(ts.Sentinels{Zero: "—"}), 0, "—"},
+		{"absolute/below", ts.AbsoluteFormatter{}.WithSentinels(sentinels), time.Nanosecond, "1ns"},
+		{"absolute/max", ts.AbsoluteFormatter{}.WithSentinels(sentinels), math.MaxInt64, "∞"},
+		{
+			"absolute/max-unset",
+			ts.AbsoluteFormatter{}.WithSentinels(ts.Sentinels{Zero: "—"}),
+			math.MaxInt64,
+			"106751d 23h 47m 16s 854ms 775µs 807ns",
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(tc.td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
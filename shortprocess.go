@@ -1,8 +1,6 @@
 package timestring
 
 import (
-	"fmt"
-	"strings"
 	"time"
 )
 
@@ -17,6 +15,11 @@ var ShortProcess Formatter = ShortProcessFormatter{abbreviated: true}
 type ShortProcessFormatter struct {
 	nospaces     bool
 	nounitspaces bool
+	units        UnitSet
+	list         ListStyle
+	pad          Padding
+	sentinels    Sentinels
+	numbers      NumberFormatter
 	abbreviated  bool // Always true for ShortProcessFormatter, but kept for interface compatibility
 }
 
@@ -40,45 +43,95 @@ func (s ShortProcessFormatter) Option(opts ...FormatterOption) Formatter {
 	return s
 }
 
+// WithUnits returns a Short Process Formatter that splits durations into the supplied units
+// instead of StandardUnits.
+func (s ShortProcessFormatter) WithUnits(units UnitSet) ShortProcessFormatter {
+	s.units = units
+
+	return s
+}
+
+// WithListStyle returns a Short Process Formatter that joins the parts of the duration using
+// the supplied list style (eg. "1h:5m:3s"), this replaces NoSpaces.
+func (s ShortProcessFormatter) WithListStyle(ls ListStyle) ShortProcessFormatter {
+	s.list = ls
+
+	return s
+}
+
+// WithPadding returns a Short Process Formatter that pads the value of each unit to a fixed width
+// (eg. "01d 02h" or " 1d  2h").
+func (s ShortProcessFormatter) WithPadding(pad Padding) ShortProcessFormatter {
+	s.pad = pad
+
+	return s
+}
+
+// WithSentinels returns a Short Process Formatter that displays the supplied strings in place of
+// zero, sub-resolution, maximum and minimum durations.
+func (s ShortProcessFormatter) WithSentinels(sentinels Sentinels) ShortProcessFormatter {
+	s.sentinels = sentinels
+
+	return s
+}
+
+// WithNumberFormat returns a Short Process Formatter that formats the value of each unit using the
+// supplied number formatter (eg. NumberEnglish for "12,345 days"), a nil formatter uses strconv.
+func (s ShortProcessFormatter) WithNumberFormat(nf NumberFormatter) ShortProcessFormatter {
+	s.numbers = nf
+
+	return s
+}
+
 // String returns a human readable string using the Short Process Formatter.
 // It always uses abbreviated units and omits zero-value units.
 //
 // Example: "1d 2h 3m 4s", "2h 3m", "4s", "0s".
 func (s ShortProcessFormatter) String(td time.Duration) string {
-	if td == 0 {
-		if s.nospaces && s.nounitspaces {
-			return "0s"
-		}
-		if s.nospaces { // implies not nounitspaces
-			return "0s"
-		}
-		// default
-		return "0s" // Short process usually returns 0s even with spaces.
+	units := s.visibleUnits(td)
+	if str, ok := s.sentinels.replace(td, units); ok {
+		return str
 	}
 
-	d := TimeDurationToDuration(td)
-	units := []timeUnit{
-		unitDay.toTimeUnit(d.Days),
-		unitHour.toTimeUnit(d.Hours),
-		unitMinute.toTimeUnit(d.Minutes),
-		unitSecond.toTimeUnit(d.Seconds),
-		unitMillisecond.toTimeUnit(d.Milliseconds),
+	parts := make([]string, 0, len(units))
+
+	for _, unit := range units {
+		parts = append(parts, s.renderUnit(unit))
 	}
-	sb := &strings.Builder{}
+
+	return joinParts(parts, s.list, s.nospaces)
+}
+
+// renderUnit returns the string representation of a single unit.
+func (s ShortProcessFormatter) renderUnit(unit timeUnit) string {
+	return s.pad.format(unit, s.renderNumber(unit), true, false)
+}
+
+// renderNumber returns the string representation of the value of a single unit.
+func (s ShortProcessFormatter) renderNumber(unit timeUnit) string {
+	return formatUnitValue(s.numbers, unit)
+}
+
+// visibleUnits returns the units of the duration that should be displayed.
+func (s ShortProcessFormatter) visibleUnits(td time.Duration) []timeUnit {
+	set := s.units.orDefault(StandardUnits)
+	if td == 0 {
+		// Short process usually returns 0s even with spaces.
+		return []timeUnit{set.zeroUnit().toTimeUnit(0)}
+	}
+
+	units := set.timeUnits(td)
+	visible := make([]timeUnit, 0, len(units))
 
 	for _, unit := range units {
 		if unit.value > 0 {
-			if s.nospaces {
-				fmt.Fprintf(sb, "%d%s", unit.value, unit.GetNameAbbrev())
-			} else {
-				fmt.Fprintf(sb, "%d%s ", unit.value, unit.GetNameAbbrev())
-			}
+			visible = append(visible, unit)
 		}
 	}
 
-	if sb.Len() == 0 {
-		return "0s"
+	if len(visible) == 0 {
+		return []timeUnit{set.zeroUnit().toTimeUnit(0)}
 	}
 
-	return strings.TrimSpace(sb.String())
+	return visible
 }
@@ -0,0 +1,79 @@
+package timestring
+
+import (
+	"log/slog"
+	"time"
+)
+
+// Keys of the group logged by a Log Formatter with raw nanoseconds.
+const (
+	LogKeyHuman       = "human"
+	LogKeyNanoseconds = "ns"
+)
+
+// LogFormatter is a Log Formatter.
+//
+// It logs durations with log/slog using another Formatter, either by wrapping a duration in a
+// slog.LogValuer or by rewriting every time.Duration attribute with ReplaceAttr.
+type LogFormatter struct {
+	formatter Formatter
+	raw       bool
+}
+
+// NewLogFormatter returns a Log Formatter that logs durations using the supplied formatter.
+func NewLogFormatter(f Formatter) LogFormatter {
+	return LogFormatter{formatter: f}
+}
+
+// WithRaw returns a Log Formatter that logs durations as a group of both the human readable form
+// and the raw nanoseconds (eg. elapsed.human="2m 3s" elapsed.ns=123000000000).
+func (l LogFormatter) WithRaw() LogFormatter {
+	l.raw = true
+
+	return l
+}
+
+// Value returns the duration as a slog.LogValuer that is logged using the Log Formatter.
+func (l LogFormatter) Value(td time.Duration) slog.LogValuer {
+	return logDuration{td: td, l: l}
+}
+
+// Attr returns a slog.Attr for the duration that is logged using the Log Formatter.
+func (l LogFormatter) Attr(key string, td time.Duration) slog.Attr {
+	return slog.Any(key, l.Value(td))
+}
+
+// ReplaceAttr rewrites attributes with a time.Duration value using the Log Formatter, it can be
+// used as the ReplaceAttr of slog.HandlerOptions.
+func (l LogFormatter) ReplaceAttr(_ []string, a slog.Attr) slog.Attr {
+	if a.Value.Kind() == slog.KindDuration {
+		a.Value = l.value(a.Value.Duration())
+	}
+
+	return a
+}
+
+// value returns the slog.Value of the duration.
+func (l LogFormatter) value(td time.Duration) slog.Value {
+	f := l.formatter
+	if f == nil {
+		f = ShortProcess
+	}
+
+	if l.raw {
+		return slog.GroupValue(slog.String(LogKeyHuman, f.String(td)), slog.Int64(LogKeyNanoseconds, int64(td)))
+	}
+
+	return slog.StringValue(f.String(td))
+}
+
+// logDuration is a duration that is logged using a Log Formatter.
+type logDuration struct {
+	td time.Duration
+	l  LogFormatter
+}
+
+// LogValue implements slog.LogValuer.
+func (d logDuration) LogValue() slog.Value {
+	return d.l.value(d.td)
+}
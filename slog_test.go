@@ -0,0 +1,104 @@
+package timestring_test
+
+import (
+	"bytes"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+// logLine returns the line logged by a text handler using the handler options.
+func logLine(opts *slog.HandlerOptions, args ...any) string {
+	buf := &bytes.Buffer{}
+	logger := slog.New(slog.NewTextHandler(buf, opts))
+	logger.Info("done", args...)
+
+	_, line, _ := strings.Cut(strings.TrimSpace(buf.String()), "msg=done ")
+
+	return line
+}
+
+func TestLogFormatter_Value(t *testing.T) {
+	t.Parallel()
+
+	td := 2*time.Minute + 3*time.Second
+
+	tcs := []struct {
+		name string
+		args []any
+		ex   string
+	}{
+		{"value", []any{"elapsed", ts.NewLogFormatter(ts.ShortProcess).Value(td)}, `elapsed="2m 3s"`},
+		{"attr", []any{ts.NewLogFormatter(ts.LongProcess).Attr("elapsed", td)}, `elapsed="2 minutes 3 seconds"`},
+		{"default", []any{ts.LogFormatter{}.Attr("elapsed", td)}, `elapsed="2m 3s"`},
+		{
+			"raw",
+			[]any{ts.NewLogFormatter(ts.ShortProcess).WithRaw().Attr("elapsed", td)},
+			`elapsed.human="2m 3s" elapsed.ns=123000000000`,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if out := logLine(nil, tc.args...); out != tc.ex {
+				t.Errorf("Info(): expected(%s) got(%s)", tc.ex, out)
+			}
+		})
+	}
+}
+
+func TestLogFormatter_ReplaceAttr(t *testing.T) {
+	t.Parallel()
+
+	td := 90 * time.Minute
+
+	tcs := []struct {
+		name string
+		l    ts.LogFormatter
+		args []any
+		ex   string
+	}{
+		{"duration", ts.NewLogFormatter(ts.ShortProcess), []any{"elapsed", td}, `elapsed="1h 30m"`},
+		{"other", ts.NewLogFormatter(ts.ShortProcess), []any{"count", 3, "name", "job"}, `count=3 name=job`},
+		{
+			"group",
+			ts.NewLogFormatter(ts.LongProcess),
+			[]any{slog.Group("req", slog.Duration("elapsed", td))},
+			`req.elapsed="1 hour 30 minutes"`,
+		},
+		{
+			"raw",
+			ts.NewLogFormatter(ts.ShortProcess).WithRaw(),
+			[]any{"elapsed", td},
+			`elapsed.human="1h 30m" elapsed.ns=5400000000000`,
+		},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if out := logLine(&slog.HandlerOptions{ReplaceAttr: tc.l.ReplaceAttr}, tc.args...); out != tc.ex {
+				t.Errorf("Info(): expected(%s) got(%s)", tc.ex, out)
+			}
+		})
+	}
+}
+
+func TestLogFormatter_JSON(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	l := ts.NewLogFormatter(ts.ShortProcess).WithRaw()
+	logger := slog.New(slog.NewJSONHandler(buf, &slog.HandlerOptions{ReplaceAttr: l.ReplaceAttr}))
+	logger.Info("done", "elapsed", 1500*time.Millisecond)
+
+	if ex := `"elapsed":{"human":"1s 500ms","ns":1500000000}`; !strings.Contains(buf.String(), ex) {
+		t.Errorf("Info(): expected(%s) in output(%s)", ex, buf.String())
+	}
+}
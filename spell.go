@@ -0,0 +1,123 @@
+package timestring
+
+import (
+	"strings"
+)
+
+// NumberSpeller is the interface that spells out the value of a unit as words.
+//
+// The unit is supplied so that the words can agree with the unit name (eg. "an hour", "a day").
+type NumberSpeller interface {
+	Spell(value int64, unit Unit) string
+}
+
+// NumberSpellerFunc is an adapter to allow the use of ordinary functions as a NumberSpeller.
+type NumberSpellerFunc func(value int64, unit Unit) string
+
+// Spell calls f(value, unit).
+func (f NumberSpellerFunc) Spell(value int64, unit Unit) string {
+	return f(value, unit)
+}
+
+// EnglishSpeller is a NumberSpeller that spells numbers out in English (eg. "twenty-one").
+type EnglishSpeller struct {
+	// Articles uses "a" or "an" instead of "one" (eg. "an hour", "a day").
+	Articles bool
+}
+
+// Spell returns the value spelled out in English.
+func (e EnglishSpeller) Spell(value int64, unit Unit) string {
+	if value == 1 && e.Articles {
+		return englishArticle(unit.NameSingular)
+	}
+
+	return SpellEnglish(value)
+}
+
+// englishArticle returns the indefinite article for the word ("a" or "an").
+func englishArticle(word string) string {
+	word = strings.ToLower(word)
+	if strings.HasPrefix(word, "hour") || strings.HasPrefix(word, "honest") {
+		return "an"
+	}
+
+	if strings.HasPrefix(word, "uni") || strings.HasPrefix(word, "use") {
+		return "a"
+	}
+
+	if word != "" && strings.ContainsRune("aeiou", rune(word[0])) {
+		return "an"
+	}
+
+	return "a"
+}
+
+//nolint:gochecknoglobals // lookup tables.
+var (
+	englishOnes = []string{
+		"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine",
+		"ten", "eleven", "twelve", "thirteen", "fourteen", "fifteen", "sixteen", "seventeen", "eighteen", "nineteen",
+	}
+	englishTens = []string{
+		"", "", "twenty", "thirty", "forty", "fifty", "sixty", "seventy", "eighty", "ninety",
+	}
+	englishScales = []string{
+		"", "thousand", "million", "billion", "trillion", "quadrillion", "quintillion",
+	}
+)
+
+// SpellEnglish returns the number spelled out in English (eg. "one hundred twenty-three").
+//
+//nolint:mnd // These _are_ magic numbers.
+func SpellEnglish(n int64) string {
+	if n == 0 {
+		return englishOnes[0]
+	}
+
+	// Use uint64 so that math.MinInt64 can be negated.
+	u := uint64(n)
+	prefix := ""
+	if n < 0 {
+		u = -u
+		prefix = "minus "
+	}
+
+	groups := []string{}
+	for scale := 0; u > 0; scale++ {
+		if group := u % 1000; group > 0 {
+			words := spellEnglishHundreds(group)
+			if englishScales[scale] != "" {
+				words += " " + englishScales[scale]
+			}
+
+			groups = append([]string{words}, groups...)
+		}
+
+		u /= 1000
+	}
+
+	return prefix + strings.Join(groups, " ")
+}
+
+// spellEnglishHundreds returns a number between 1 and 999 spelled out in English.
+//
+//nolint:mnd // These _are_ magic numbers.
+func spellEnglishHundreds(n uint64) string {
+	words := []string{}
+	if n >= 100 {
+		words = append(words, englishOnes[n/100], "hundred")
+		n %= 100
+	}
+
+	switch {
+	case n == 0:
+	case n < 20:
+		words = append(words, englishOnes[n])
+	case n%10 == 0:
+		words = append(words, englishTens[n/10])
+	default:
+		words = append(words, englishTens[n/10]+"-"+englishOnes[n%10])
+	}
+
+	return strings.Join(words, " ")
+}
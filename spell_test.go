@@ -0,0 +1,90 @@
+package timestring_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestSpellEnglish(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		n  int64
+		ex string
+	}{
+		{0, "zero"},
+		{1, "one"},
+		{13, "thirteen"},
+		{20, "twenty"},
+		{21, "twenty-one"},
+		{100, "one hundred"},
+		{105, "one hundred five"},
+		{999, "nine hundred ninety-nine"},
+		{1000, "one thousand"},
+		{12345, "twelve thousand three hundred forty-five"},
+		{1000001, "one million one"},
+		{-42, "minus forty-two"},
+		{
+			math.MinInt64,
+			"minus nine quintillion two hundred twenty-three quadrillion three hundred seventy-two trillion " +
+				"thirty-six billion eight hundred fifty-four million seven hundred seventy-five thousand eight hundred eight",
+		},
+	}
+
+	for _, tc := range tcs {
+		if o := ts.SpellEnglish(tc.n); o != tc.ex {
+			t.Errorf("SpellEnglish(%d) expected(%s) got(%s)", tc.n, tc.ex, o)
+		}
+	}
+}
+
+func TestSpelledNumbersLongProcess(t *testing.T) {
+	t.Parallel()
+
+	french := ts.NumberSpellerFunc(func(value int64, _ ts.Unit) string {
+		return map[int64]string{1: "un", 2: "deux", 5: "cinq"}[value]
+	})
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		td   time.Duration
+		ex   string
+	}{
+		{
+			"plain",
+			ts.LongProcessFormatter{}.WithSpelledNumbers(ts.EnglishSpeller{}),
+			2*time.Hour + 5*time.Minute,
+			"two hours five minutes",
+		},
+		{
+			"list",
+			ts.LongProcessFormatter{}.WithSpelledNumbers(ts.EnglishSpeller{}).WithListStyle(ts.ListEnglish),
+			2*time.Hour + 5*time.Minute,
+			"two hours and five minutes",
+		},
+		{
+			"articles",
+			ts.LongProcessFormatter{}.WithSpelledNumbers(ts.EnglishSpeller{Articles: true}).WithListStyle(ts.ListCommas),
+			27*time.Hour + time.Minute,
+			"a day, three hours, a minute",
+		},
+		{"an-hour", ts.LongProcessFormatter{}.WithSpelledNumbers(ts.EnglishSpeller{Articles: true}), time.Hour, "an hour"},
+		{"zero", ts.LongProcessFormatter{}.WithSpelledNumbers(ts.EnglishSpeller{}), 0, "zero seconds"},
+		{"custom", ts.LongProcessFormatter{}.WithSpelledNumbers(french), 2*time.Hour + time.Minute, "deux hours un minute"},
+		{"nil", ts.LongProcessFormatter{}.WithSpelledNumbers(nil), time.Hour, "1 hour"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(tc.td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package timestring
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SQLFormat is the canonical form that SQLDuration writes to the database.
+type SQLFormat uint
+
+const (
+	// SQLNanoseconds writes the duration as an integer number of nanoseconds.
+	SQLNanoseconds SQLFormat = iota
+
+	// SQLSeconds writes the duration as a floating point number of seconds.
+	SQLSeconds
+
+	// SQLInterval writes the duration as interval text in hours, minutes and seconds (eg. "26:03:04.5"),
+	// which is accepted by the interval type of Postgres.
+	SQLInterval
+
+	// SQLHuman writes the duration as text using ShortProcess (eg. "1d 2h 3m 4s 500ms"), durations
+	// that ShortProcess can not display exactly are written as time.Duration.String (eg. "-5s" or "1.5ms").
+	SQLHuman
+)
+
+// Interval units used when scanning interval text, a year is 365.25 days and a month is 30 days
+// (as EXTRACT(EPOCH FROM interval) in Postgres).
+//
+//nolint:gochecknoglobals,mnd // lookup table.
+var sqlIntervalUnits = map[string]time.Duration{
+	"year": 8766 * time.Hour, "years": 8766 * time.Hour,
+	"mon": 720 * time.Hour, "mons": 720 * time.Hour, "month": 720 * time.Hour, "months": 720 * time.Hour,
+	"week": 7 * 24 * time.Hour, "weeks": 7 * 24 * time.Hour,
+	"day": 24 * time.Hour, "days": 24 * time.Hour,
+}
+
+// SQLDuration is a time.Duration that implements sql.Scanner and driver.Valuer.
+//
+// It scans integers as nanoseconds, floats as seconds, interval text (eg. "1 day 02:03:04.5" or
+// ISO 8601 "P1DT2H3M4.5S") and anything accepted by Parse, and writes the duration in the form
+// chosen by Format. A NULL value scans as an invalid duration and an invalid duration is written as NULL.
+type SQLDuration struct {
+	Duration time.Duration
+	Valid    bool      // Valid is true if Duration is not NULL
+	Format   SQLFormat // Format is the form written to the database, it is not changed by Scan
+}
+
+// Scan implements sql.Scanner.
+func (d *SQLDuration) Scan(src any) error {
+	var err error
+
+	switch v := src.(type) {
+	case nil:
+		d.Duration, d.Valid = 0, false
+
+		return nil
+	case int64:
+		d.Duration = time.Duration(v)
+	case float64:
+		d.Duration, err = DurationOf(v, time.Second)
+	case []byte:
+		d.Duration, err = parseSQLText(string(v))
+	case string:
+		d.Duration, err = parseSQLText(v)
+	default:
+		return fmt.Errorf("%w: unable to scan %T", ErrInvalidDuration, src)
+	}
+
+	if err != nil {
+		d.Duration, d.Valid = 0, false
+
+		return err
+	}
+
+	d.Valid = true
+
+	return nil
+}
+
+// Value implements driver.Valuer.
+func (d SQLDuration) Value() (driver.Value, error) {
+	if !d.Valid {
+		return nil, nil //nolint:nilnil // NULL is a nil value.
+	}
+
+	switch d.Format {
+	case SQLSeconds:
+		return d.Duration.Seconds(), nil
+	case SQLInterval:
+		return formatSQLInterval(d.Duration), nil
+	case SQLHuman:
+		return formatLossless(ShortProcess, d.Duration), nil
+	default:
+		return int64(d.Duration), nil
+	}
+}
+
+// formatSQLInterval returns the duration as interval text in hours, minutes and seconds.
+//
+//nolint:mnd // These _are_ magic numbers.
+func formatSQLInterval(td time.Duration) string {
+	sign := ""
+	if td < 0 {
+		sign = "-"
+	}
+
+	// Use the magnitude as an unsigned value so that math.MinInt64 does not overflow.
+	ns := uint64(td)
+	if td < 0 {
+		ns = -ns
+	}
+
+	secs := ns / uint64(time.Second)
+	out := fmt.Sprintf("%s%02d:%02d:%02d", sign, secs/3600, secs/60%60, secs%60)
+
+	if frac := ns % uint64(time.Second); frac != 0 {
+		out += "." + strings.TrimRight(strconv.FormatUint(frac+1e9, 10)[1:], "0")
+	}
+
+	return out
+}
+
+// parseSQLText parses a duration read from the database as text, in the order integer nanoseconds,
+// float seconds, ISO 8601, interval text and Parse.
+func parseSQLText(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+
+	if ns, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return time.Duration(ns), nil
+	}
+
+	if f, err := strconv.ParseFloat(s, 64); err == nil && !math.IsInf(f, 0) && !math.IsNaN(f) {
+		return DurationOf(f, time.Second)
+	}
+
+	if td, ok := parseISO8601(s); ok {
+		return td, nil
+	}
+
+	if td, ok := parseSQLInterval(s); ok {
+		return td, nil
+	}
+
+	return Parse(s)
+}
+
+// parseSQLInterval parses interval text in the Postgres output style, such as "1 day 02:03:04.5",
+// "-01:00:00" or "1 year 2 mons", the result is false if it is not interval text.
+func parseSQLInterval(s string) (time.Duration, bool) {
+	var total float64
+
+	fields := strings.Fields(s)
+	for i := 0; i < len(fields); i++ {
+		if strings.Contains(fields[i], ":") {
+			td, ok := parseSQLClock(fields[i])
+			if !ok {
+				return 0, false
+			}
+
+			total += float64(td)
+
+			continue
+		}
+
+		if i+1 >= len(fields) {
+			return 0, false
+		}
+
+		n, err := strconv.ParseFloat(fields[i], 64)
+		size, ok := sqlIntervalUnits[strings.ToLower(fields[i+1])]
+		if err != nil || !ok {
+			return 0, false
+		}
+
+		total += n * float64(size)
+		i++
+	}
+
+	if len(fields) == 0 || total >= math.MaxInt64 || total < math.MinInt64 {
+		return 0, false
+	}
+
+	return time.Duration(math.Round(total)), true
+}
+
+// parseSQLClock parses "[-]HH:MM[:SS[.fraction]]", the result is false if it is not valid or the
+// minutes or seconds are 60 or more.
+//
+//nolint:mnd // These _are_ magic numbers.
+func parseSQLClock(s string) (time.Duration, bool) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+
+	parts := strings.Split(s, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, false
+	}
+
+	sizes := []time.Duration{time.Hour, time.Minute, time.Second}
+
+	var total float64
+
+	for i, part := range parts {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil || n < 0 || (i > 0 && n >= 60) || (i < len(parts)-1 && strings.Contains(part, ".")) {
+			return 0, false
+		}
+
+		total += n * float64(sizes[i])
+	}
+
+	if neg {
+		total = -total
+	}
+
+	return time.Duration(math.Round(total)), true
+}
+
+// parseISO8601 parses an ISO 8601 duration (eg. "P1DT2H3M4.5S" or "-PT1H") as written by the
+// ISO 8601 Formatter, years and months use the same lengths as interval text.
+//
+//nolint:mnd // These _are_ magic numbers.
+func parseISO8601(s string) (time.Duration, bool) {
+	neg := strings.HasPrefix(s, "-")
+	s = strings.TrimLeft(s, "+-")
+
+	if !strings.HasPrefix(s, "P") || len(s) < 3 {
+		return 0, false
+	}
+
+	dateUnits := map[byte]time.Duration{
+		'Y': sqlIntervalUnits["year"], 'M': sqlIntervalUnits["month"], 'W': sqlIntervalUnits["week"], 'D': 24 * time.Hour,
+	}
+	timeUnits := map[byte]time.Duration{'H': time.Hour, 'M': time.Minute, 'S': time.Second}
+
+	var total float64
+
+	units, rest := dateUnits, s[1:]
+	for rest != "" {
+		if rest[0] == 'T' {
+			units, rest = timeUnits, rest[1:]
+
+			continue
+		}
+
+		end := strings.IndexFunc(rest, func(r rune) bool { return (r < '0' || r > '9') && r != '.' && r != ',' })
+		if end <= 0 {
+			return 0, false
+		}
+
+		n, err := strconv.ParseFloat(strings.ReplaceAll(rest[:end], ",", "."), 64)
+		size, ok := units[rest[end]]
+		if err != nil || !ok {
+			return 0, false
+		}
+
+		total += n * float64(size)
+		rest = rest[end+1:]
+	}
+
+	if neg {
+		total = -total
+	}
+
+	if total >= math.MaxInt64 || total < math.MinInt64 {
+		return 0, false
+	}
+
+	return time.Duration(math.Round(total)), true
+}
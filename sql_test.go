@@ -0,0 +1,218 @@
+package timestring_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+// fakeDriver is an in-memory database/sql driver with a single column table per DSN,
+// "INSERT" appends the argument and "SELECT" returns every inserted value.
+type fakeDriver struct {
+	mu     sync.Mutex
+	tables map[string][]driver.Value
+}
+
+//nolint:gochecknoglobals // registered once for the tests.
+var fakeDB = &fakeDriver{tables: map[string][]driver.Value{}}
+
+func init() { //nolint:gochecknoinits // register the fake driver.
+	sql.Register("timestring-fake", fakeDB)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	return &fakeConn{driver: d, table: name}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	table  string
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error { return nil }
+
+func (c *fakeConn) Begin() (driver.Tx, error) { return nil, errors.New("not supported") }
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error  { return nil }
+func (s *fakeStmt) NumInput() int { return -1 }
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !strings.HasPrefix(s.query, "INSERT") || len(args) != 1 {
+		return nil, errors.New("unsupported query")
+	}
+
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+
+	s.conn.driver.tables[s.conn.table] = append(s.conn.driver.tables[s.conn.table], args[0])
+
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query([]driver.Value) (driver.Rows, error) {
+	if !strings.HasPrefix(s.query, "SELECT") {
+		return nil, errors.New("unsupported query")
+	}
+
+	s.conn.driver.mu.Lock()
+	defer s.conn.driver.mu.Unlock()
+
+	return &fakeRows{values: append([]driver.Value{}, s.conn.driver.tables[s.conn.table]...)}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+}
+
+func (r *fakeRows) Columns() []string { return []string{"duration"} }
+func (r *fakeRows) Close() error      { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+
+	dest[0], r.values = r.values[0], r.values[1:]
+
+	return nil
+}
+
+// openFakeDB returns a database with an empty table named after the test.
+func openFakeDB(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("timestring-fake", t.Name())
+	if err != nil {
+		t.Fatalf("sql.Open(): unexpected error: %s", err)
+	}
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db
+}
+
+func TestSQLDuration_Value(t *testing.T) {
+	t.Parallel()
+
+	td := 26*time.Hour + 3*time.Minute + 4*time.Second + 500*time.Millisecond
+
+	tcs := []struct {
+		name string
+		d    ts.SQLDuration
+		ex   driver.Value
+	}{
+		{"nanoseconds", ts.SQLDuration{Duration: td, Valid: true}, int64(td)},
+		{"seconds", ts.SQLDuration{Duration: td, Valid: true, Format: ts.SQLSeconds}, 93784.5},
+		{"interval", ts.SQLDuration{Duration: td, Valid: true, Format: ts.SQLInterval}, "26:03:04.5"},
+		{"interval/negative", ts.SQLDuration{Duration: -90 * time.Second, Valid: true, Format: ts.SQLInterval}, "-00:01:30"},
+		{"human", ts.SQLDuration{Duration: td, Valid: true, Format: ts.SQLHuman}, "1d 2h 3m 4s 500ms"},
+		{"human/negative", ts.SQLDuration{Duration: -5 * time.Second, Valid: true, Format: ts.SQLHuman}, "-5s"},
+		{
+			"human/sub-millisecond",
+			ts.SQLDuration{Duration: 1500 * time.Microsecond, Valid: true, Format: ts.SQLHuman},
+			"1.5ms",
+		},
+		{"null", ts.SQLDuration{Duration: td, Format: ts.SQLHuman}, nil},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			db := openFakeDB(t)
+			if _, err := db.ExecContext(context.Background(), "INSERT", tc.d); err != nil {
+				t.Fatalf("Exec(): unexpected error: %s", err)
+			}
+
+			var raw any
+			if err := db.QueryRowContext(context.Background(), "SELECT").Scan(&raw); err != nil {
+				t.Fatalf("Scan(): unexpected error: %s", err)
+			}
+
+			if raw != tc.ex {
+				t.Errorf("Value(): expected(%#v) got(%#v)", tc.ex, raw)
+			}
+
+			var out ts.SQLDuration
+			if err := db.QueryRowContext(context.Background(), "SELECT").Scan(&out); err != nil {
+				t.Fatalf("Scan(): unexpected error: %s", err)
+			}
+
+			if out.Valid != tc.d.Valid || (out.Valid && out.Duration != tc.d.Duration) {
+				t.Errorf("Scan(): expected(%s, %t) got(%s, %t)", tc.d.Duration, tc.d.Valid, out.Duration, out.Valid)
+			}
+		})
+	}
+}
+
+func TestSQLDuration_Scan(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		src  driver.Value
+		ex   time.Duration
+		err  error
+	}{
+		{"int64", int64(1500), 1500, nil},
+		{"float64", 1.5, 1500 * time.Millisecond, nil},
+		{"text/int", "90000000000", 90 * time.Second, nil},
+		{"text/float", []byte("0.25"), 250 * time.Millisecond, nil},
+		{"interval/clock", "26:03:04.5", 26*time.Hour + 3*time.Minute + 4500*time.Millisecond, nil},
+		{"interval/negative", "-01:30:00", -90 * time.Minute, nil},
+		{"interval/days", []byte("1 day 02:03:04"), 26*time.Hour + 3*time.Minute + 4*time.Second, nil},
+		{"interval/months", "1 year 2 mons 3 days", (8766 + 2*720 + 3*24) * time.Hour, nil},
+		{"interval/mixed-signs", "1 day -01:00:00", 23 * time.Hour, nil},
+		{"iso8601", "P1DT2H3M4.5S", 26*time.Hour + 3*time.Minute + 4500*time.Millisecond, nil},
+		{"iso8601/negative", "-PT1H", -time.Hour, nil},
+		{"iso8601/weeks", "P2W", 14 * 24 * time.Hour, nil},
+		{"human", "2 hours 30 minutes", 150 * time.Minute, nil},
+		{"human/short", []byte("1d 2h 3m"), 26*time.Hour + 3*time.Minute, nil},
+		{"invalid", "soon", 0, ts.ErrInvalidDuration},
+		{"invalid/clock", "1:2:3:4", 0, ts.ErrInvalidDuration},
+		{"invalid/minutes", "10:61", 0, ts.ErrInvalidDuration},
+		{"invalid/seconds", "10:30:60", 0, ts.ErrInvalidDuration},
+		{"invalid/days-clock", "1 day 00:75:00", 0, ts.ErrInvalidDuration},
+		{"invalid/type", true, 0, ts.ErrInvalidDuration},
+		{"overflow", 1e12, 0, ts.ErrDurationOverflow},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var out ts.SQLDuration
+
+			err := out.Scan(tc.src)
+			if !errors.Is(err, tc.err) {
+				t.Fatalf("Scan(%#v): expected error(%v) got(%v)", tc.src, tc.err, err)
+			}
+
+			if out.Valid != (tc.err == nil) || out.Duration != tc.ex {
+				t.Errorf("Scan(%#v): expected(%s, %t) got(%s, %t)", tc.src, tc.ex, tc.err == nil, out.Duration, out.Valid)
+			}
+		})
+	}
+
+	out := ts.SQLDuration{Duration: time.Hour, Valid: true, Format: ts.SQLInterval}
+	if err := out.Scan(nil); err != nil || out.Valid || out.Duration != 0 || out.Format != ts.SQLInterval {
+		t.Errorf("Scan(nil): unexpected result %+v, %v", out, err)
+	}
+}
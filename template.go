@@ -0,0 +1,296 @@
+package timestring
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"reflect"
+	texttemplate "text/template"
+	"time"
+)
+
+// templateOptions are the names of the formatter options accepted by the template functions.
+//
+//nolint:gochecknoglobals // lookup table.
+var templateOptions = map[string]FormatterOption{
+	"abbreviated":  Abbreviated,
+	"nospaces":     NoSpaces,
+	"nounitspaces": NoUnitSpaces,
+	"ms":           ShowMSOnSeconds,
+}
+
+// templateFormatters are the names of the formatters that can be chosen by the "ago" and
+// "timeTag" template functions.
+//
+//nolint:gochecknoglobals // lookup table.
+var templateFormatters = map[string]Formatter{
+	"long":     LongProcess,
+	"short":    ShortProcess,
+	"absolute": Absolute,
+}
+
+// templateUnits are the names of the units that plain numbers can be declared in.
+//
+//nolint:gochecknoglobals,mnd // lookup table.
+var templateUnits = map[string]time.Duration{
+	"nanoseconds":  time.Nanosecond,
+	"microseconds": time.Microsecond,
+	"milliseconds": time.Millisecond,
+	"seconds":      time.Second,
+	"minutes":      time.Minute,
+	"hours":        time.Hour,
+	"days":         24 * time.Hour,
+}
+
+// TemplateFuncs returns a text/template function map exposing the formatters, the clock is used
+// for time.Time values and a nil clock uses SystemClock.
+//
+//   - long, short, absolute: the duration using LongProcess, ShortProcess or Absolute (eg. {{ long .Uptime }})
+//   - ago: the duration as a relative phrase using LongProcess (eg. "3 hours ago" or "in 5 minutes")
+//   - iso8601: the duration in the ISO 8601 duration format (eg. "PT3H20M")
+//
+// The functions accept a time.Duration, a Duration, BigDuration, SQLDuration, Human, or a time.Time
+// as the time since then (or until then for a future time, as a negative duration), truncated to the
+// second. Other integers and floats are seconds unless a unit is named after the value, one of
+// "nanoseconds", "microseconds", "milliseconds", "seconds", "minutes", "hours" or "days"
+// (eg. {{ long .TimeoutMS "milliseconds" }}).
+//
+// long, short, absolute and iso8601 display the magnitude of a time.Time, so a time 5 minutes ago
+// or in 5 minutes are both "5 minutes".
+//
+// Formatter options are also passed by name after the value (eg. {{ long .Uptime "abbreviated" }}),
+// the names are "abbreviated", "nospaces", "nounitspaces" and "ms" (ShowMSOnSeconds), "ago" also
+// accepts "long", "short" or "absolute" to choose the formatter.
+func TemplateFuncs(clock Clock) texttemplate.FuncMap {
+	t := templateFuncs{clock: clock}
+
+	return texttemplate.FuncMap{
+		"long":     t.formatWith(LongProcess),
+		"short":    t.formatWith(ShortProcess),
+		"absolute": t.formatWith(Absolute),
+		"ago":      t.ago,
+		"iso8601":  t.iso8601,
+	}
+}
+
+// HTMLTemplateFuncs returns an html/template function map with the functions of TemplateFuncs and:
+//
+//   - timeTag: a <time> element with the magnitude of the duration in the ISO 8601 format as the
+//     datetime attribute and the duration using LongProcess as the content, a time.Time is displayed
+//     as a relative phrase like "ago" (eg. <time datetime="PT1H30M">1 hour 30 minutes</time> or
+//     <time datetime="PT5M">in 5 minutes</time>), options are the same as "ago", the datetime
+//     attribute is truncated to milliseconds
+//
+// timeTag returns an error for a negative duration, which is not a valid HTML duration.
+func HTMLTemplateFuncs(clock Clock) htmltemplate.FuncMap {
+	funcs := htmltemplate.FuncMap(TemplateFuncs(clock))
+	funcs["timeTag"] = templateFuncs{clock: clock}.timeTag
+
+	return funcs
+}
+
+// templateFuncs implements the template functions.
+type templateFuncs struct {
+	clock Clock
+}
+
+// templateValue is the value passed to a template function.
+type templateValue struct {
+	bd     BigDuration
+	isTime bool // isTime is true if the value was a time.Time
+}
+
+// magnitude returns the duration without the sign for a time.Time value.
+func (v templateValue) magnitude() BigDuration {
+	if v.isTime && v.bd.Sign() < 0 {
+		return v.bd.Neg()
+	}
+
+	return v.bd
+}
+
+// formatWith returns a template function that formats the value using the formatter.
+func (t templateFuncs) formatWith(f Formatter) func(v any, opts ...string) (string, error) {
+	return func(v any, opts ...string) (string, error) {
+		val, f, err := t.args(v, f, opts, false)
+		if err != nil {
+			return "", err
+		}
+
+		return FormatBigDuration(f, val.magnitude())
+	}
+}
+
+// ago returns the value as a relative phrase (eg. "3 hours ago", "in 5 minutes" or "just now").
+func (t templateFuncs) ago(v any, opts ...string) (string, error) {
+	val, f, err := t.args(v, LongProcess, opts, true)
+	if err != nil {
+		return "", err
+	}
+
+	return relativePhrase(f, val.bd)
+}
+
+// relativePhrase returns the duration as a relative phrase, a negative duration is in the future.
+func relativePhrase(f Formatter, bd BigDuration) (string, error) {
+	switch bd.Sign() {
+	case 0:
+		return "just now", nil
+	case -1:
+		out, err := FormatBigDuration(f, bd.Neg())
+
+		return "in " + out, err
+	default:
+		out, err := FormatBigDuration(f, bd)
+
+		return out + " ago", err
+	}
+}
+
+// iso8601 returns the value in the ISO 8601 duration format.
+func (t templateFuncs) iso8601(v any, opts ...string) (string, error) {
+	val, _, err := t.args(v, ISO8601, opts, false)
+	if err != nil {
+		return "", err
+	}
+
+	td, err := timeDuration(val.magnitude())
+	if err != nil {
+		return "", err
+	}
+
+	return ISO8601.String(td), nil
+}
+
+// timeTag returns the value as an HTML time element.
+func (t templateFuncs) timeTag(v any, opts ...string) (htmltemplate.HTML, error) {
+	val, f, err := t.args(v, LongProcess, opts, true)
+	if err != nil {
+		return "", err
+	}
+
+	if !val.isTime && val.bd.Sign() < 0 {
+		return "", fmt.Errorf("%w: negative duration %s is not a valid HTML duration", ErrInvalidDuration, val.bd)
+	}
+
+	td, err := timeDuration(val.magnitude())
+	if err != nil {
+		return "", err
+	}
+
+	content := f.String(td)
+	if val.isTime {
+		if content, err = relativePhrase(f, val.bd); err != nil {
+			return "", err
+		}
+	}
+
+	// A valid HTML duration has at most 3 fractional digits for the seconds.
+	datetime := ISO8601.String(td.Truncate(time.Millisecond))
+
+	//nolint:gosec // the attribute and content are escaped.
+	return htmltemplate.HTML(fmt.Sprintf(`<time datetime="%s">%s</time>`,
+		htmltemplate.HTMLEscapeString(datetime), htmltemplate.HTMLEscapeString(content),
+	)), nil
+}
+
+// args returns the value and the formatter with the named options applied, if choose is true the
+// formatter can be chosen by name.
+func (t templateFuncs) args(v any, f Formatter, names []string, choose bool) (templateValue, Formatter, error) {
+	opts := make([]FormatterOption, 0, len(names))
+	unit := time.Second
+
+	for _, name := range names {
+		if chosen, ok := templateFormatters[name]; ok && choose {
+			f = chosen
+
+			continue
+		}
+
+		if size, ok := templateUnits[name]; ok {
+			unit = size
+
+			continue
+		}
+
+		opt, ok := templateOptions[name]
+		if !ok {
+			return templateValue{}, nil, fmt.Errorf("unknown formatter option %q", name)
+		}
+
+		opts = append(opts, opt)
+	}
+
+	val, err := t.value(v, unit)
+	if err != nil {
+		return templateValue{}, nil, err
+	}
+
+	return val, f.Option(opts...), nil
+}
+
+// timeDuration returns the BigDuration as a time.Duration, ErrDurationOverflow is returned if it does not fit.
+func timeDuration(bd BigDuration) (time.Duration, error) {
+	td, ok := bd.Duration()
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", ErrDurationOverflow, bd)
+	}
+
+	return td, nil
+}
+
+// value returns the value of a template function argument, plain numbers are in units of unit.
+func (t templateFuncs) value(v any, unit time.Duration) (templateValue, error) {
+	switch v := v.(type) {
+	case time.Time:
+		return templateValue{bd: NewBigDuration(Since(t.clock, v).Truncate(time.Second)), isTime: true}, nil
+	case *time.Time:
+		if v == nil {
+			return templateValue{}, fmt.Errorf("%w: nil time", ErrInvalidDuration)
+		}
+
+		return t.value(*v, unit)
+	case time.Duration:
+		return templateValue{bd: NewBigDuration(v)}, nil
+	case *time.Duration:
+		if v == nil {
+			return templateValue{}, fmt.Errorf("%w: nil duration", ErrInvalidDuration)
+		}
+
+		return templateValue{bd: NewBigDuration(*v)}, nil
+	case interface{ Duration() time.Duration }:
+		return templateValue{bd: NewBigDuration(v.Duration())}, nil
+	case Duration:
+		return templateValue{bd: v.BigDuration()}, nil
+	case BigDuration:
+		return templateValue{bd: v}, nil
+	case SQLDuration:
+		if !v.Valid {
+			return templateValue{}, fmt.Errorf("%w: NULL duration", ErrInvalidDuration)
+		}
+
+		return templateValue{bd: NewBigDuration(v.Duration)}, nil
+	}
+
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Pointer && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+
+	var (
+		bd  BigDuration
+		err error
+	)
+
+	switch rv.Kind() { //nolint:exhaustive // other kinds are not durations.
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		bd, err = BigDurationOfNumber(rv.Int(), unit)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		bd, err = BigDurationOfNumber(rv.Uint(), unit)
+	case reflect.Float32, reflect.Float64:
+		bd, err = BigDurationOfNumber(rv.Float(), unit)
+	default:
+		err = fmt.Errorf("%w: unable to use %T as a duration", ErrInvalidDuration, v)
+	}
+
+	return templateValue{bd: bd}, err
+}
@@ -0,0 +1,135 @@
+package timestring_test
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"math/big"
+	"strings"
+	"testing"
+	texttemplate "text/template"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestTemplateFuncs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	clock := ts.ClockFunc(func() time.Time { return now })
+
+	data := map[string]any{
+		"Uptime":   26*time.Hour + 3*time.Minute,
+		"Started":  now.Add(-(3*time.Hour + 20*time.Minute + 500*time.Millisecond)),
+		"Next":     now.Add(5 * time.Minute),
+		"Now":      now,
+		"Seconds":  1.5,
+		"Nanos":    int64(90 * time.Second),
+		"Millis":   uint32(1500),
+		"Human":    ts.Human(time.Hour),
+		"Fields":   ts.Duration{Minutes: 90},
+		"Big":      ts.BigDurationOf(big.NewInt(350), ts.UnitYear.Size),
+		"Null":     ts.SQLDuration{},
+		"Duration": new(time.Duration),
+	}
+
+	tcs := []struct {
+		tmpl string
+		ex   string
+		err  string
+	}{
+		{`{{ long .Uptime }}`, "1 day 2 hours 3 minutes", ""},
+		{`{{ long .Uptime "abbreviated" "nospaces" }}`, "1d2h3m", ""},
+		{`{{ short .Uptime }}`, "1d 2h 3m", ""},
+		{`{{ absolute .Seconds }}`, "1s 500ms", ""},
+		{`{{ short .Nanos "nanoseconds" }}`, "1m 30s", ""},
+		{`{{ long 90 }}`, "1 minute 30 seconds", ""},
+		{`{{ long 90.0 }}`, "1 minute 30 seconds", ""},
+		{`{{ short .Millis "milliseconds" }}`, "1s 500ms", ""},
+		{`{{ short 1.5 "hours" "abbreviated" }}`, "1h 30m", ""},
+		{`{{ short .Next }}`, "5m", ""},
+		{`{{ long .Next }}`, "5 minutes", ""},
+		{`{{ iso8601 .Next }}`, "PT5M", ""},
+		{`{{ short .Human }}`, "1h", ""},
+		{`{{ short .Fields }}`, "1h 30m", ""},
+		{`{{ long .Big }}`, "3 centuries 50 years", ""},
+		{`{{ short .Started }}`, "3h 20m", ""},
+		{`{{ long .Seconds "ms" }}`, "1 second 500 milliseconds", ""},
+		{`{{ short .Duration }}`, "0s", ""},
+		{`{{ ago .Started }}`, "3 hours 20 minutes ago", ""},
+		{`{{ ago .Started "short" }}`, "3h 20m ago", ""},
+		{`{{ ago .Next "long" "abbreviated" }}`, "in 5m", ""},
+		{`{{ ago .Now }}`, "just now", ""},
+		{`{{ iso8601 .Uptime }}`, "P1DT2H3M", ""},
+		{`{{ long .Uptime "short" }}`, "", `unknown formatter option "short"`},
+		{`{{ long .Uptime "bold" }}`, "", `unknown formatter option "bold"`},
+		{`{{ long "soon" }}`, "", "unable to use string as a duration"},
+		{`{{ long .Null }}`, "", "NULL duration"},
+		{`{{ iso8601 .Big }}`, "", "duration overflows time.Duration"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.tmpl, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl := texttemplate.Must(texttemplate.New("test").Funcs(ts.TemplateFuncs(clock)).Parse(tc.tmpl))
+			buf := &bytes.Buffer{}
+
+			err := tmpl.Execute(buf, data)
+			if (err == nil) != (tc.err == "") || (err != nil && !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("Execute(): expected error(%s) got(%v)", tc.err, err)
+			}
+
+			if err == nil && buf.String() != tc.ex {
+				t.Errorf("Execute(): expected(%s) got(%s)", tc.ex, buf.String())
+			}
+		})
+	}
+}
+
+func TestHTMLTemplateFuncs(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	clock := ts.ClockFunc(func() time.Time { return now })
+
+	data := map[string]any{
+		"Elapsed":  90 * time.Minute,
+		"Negative": -90 * time.Minute,
+		"SubMilli": 1234567 * time.Nanosecond,
+		"Future":   now.Add(5 * time.Minute),
+		"Past":     now.Add(-2 * time.Hour),
+	}
+
+	tcs := []struct {
+		tmpl string
+		ex   string
+		err  string
+	}{
+		{`{{ timeTag .Elapsed }}`, `<time datetime="PT1H30M">1 hour 30 minutes</time>`, ""},
+		{`{{ timeTag .Elapsed "short" }}`, `<time datetime="PT1H30M">1h 30m</time>`, ""},
+		{`{{ timeTag .SubMilli "absolute" }}`, `<time datetime="PT0.001S">1ms 234µs 567ns</time>`, ""},
+		{`{{ timeTag .Future }}`, `<time datetime="PT5M">in 5 minutes</time>`, ""},
+		{`{{ timeTag .Past "short" }}`, `<time datetime="PT2H">2h ago</time>`, ""},
+		{`{{ timeTag .Negative }}`, "", "is not a valid HTML duration"},
+		{`<p title="{{ short .Elapsed }}">{{ long .Elapsed }}</p>`, `<p title="1h 30m">1 hour 30 minutes</p>`, ""},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.tmpl, func(t *testing.T) {
+			t.Parallel()
+
+			tmpl := htmltemplate.Must(htmltemplate.New("test").Funcs(ts.HTMLTemplateFuncs(clock)).Parse(tc.tmpl))
+			buf := &bytes.Buffer{}
+
+			err := tmpl.Execute(buf, data)
+			if (err == nil) != (tc.err == "") || (err != nil && !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("Execute(): expected error(%s) got(%v)", tc.err, err)
+			}
+
+			if err == nil && buf.String() != tc.ex {
+				t.Errorf("Execute(): expected(%s) got(%s)", tc.ex, buf.String())
+			}
+		})
+	}
+}
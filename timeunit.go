@@ -1,91 +1,109 @@
 package timestring
 
-import "strconv"
+import (
+	"math/big"
+	"strconv"
+	"time"
+)
 
-type globalTimeUnit struct {
-	nameSingular  string
-	namePlural    string
-	nameAbbrev    string
-	showZero      bool // Flag to show this unit even if its value is zero (e.g., "0 seconds")
-	onlyIfSeconds bool // Flag to show this unit only if total duration is less than 60 seconds (for milliseconds)
+// Unit is the definition of a single unit of time used by the formatters.
+//
+// Custom units can be defined to rename the standard units (eg. "min" instead of "m"),
+// add new units (eg. weeks) or model domain specific units (eg. game ticks), and are
+// grouped together into a UnitSet that the formatters accept.
+type Unit struct {
+	NameSingular  string
+	NamePlural    string
+	NameAbbrev    string
+	Size          time.Duration // Length of a single unit, units with a size of zero or less are ignored
+	ShowZero      bool          // Flag to show this unit even if its value is zero (e.g., "0 seconds")
+	OnlyIfSeconds bool          // Flag to show this unit only if total duration is less than 60 seconds (for ms)
 }
 
-// toTimeUnit converts a globalTimeUnit to a timeUnit with the specified value.
+// toTimeUnit converts a Unit to a timeUnit with the specified value.
 // This is used to create timeUnit instances with specific values in the formatters.
-// It allows for easy conversion from the global time unit definitions to the specific time unit instances
+// It allows for easy conversion from the unit definitions to the specific time unit instances
 // used in the ShortProcessFormatter and LongProcessFormatter.
-func (gtu globalTimeUnit) toTimeUnit(value int64) timeUnit {
+func (gtu Unit) toTimeUnit(value int64) timeUnit {
 	return timeUnit{
 		value: value,
 		unit:  gtu,
 	}
 }
 
-// IsOnlyIfSeconds returns true if this global time unit should only be shown if the total duration is less than 60 seconds.
-func (gtu globalTimeUnit) IsOnlyIfSeconds() bool {
-	return gtu.onlyIfSeconds
+// IsOnlyIfSeconds returns true if this unit should only be shown if the total duration is less than 60 seconds.
+func (gtu Unit) IsOnlyIfSeconds() bool {
+	return gtu.OnlyIfSeconds
 }
 
-// IsShowZero returns true if this global time unit should be shown even if its value is zero.
-func (gtu globalTimeUnit) IsShowZero() bool {
-	return gtu.showZero
+// IsShowZero returns true if this unit should be shown even if its value is zero.
+func (gtu Unit) IsShowZero() bool {
+	return gtu.ShowZero
 }
 
 // GetNameSingular returns the singular name of the time unit.
-func (gtu globalTimeUnit) GetNameSingular() string {
-	return gtu.nameSingular
+func (gtu Unit) GetNameSingular() string {
+	return gtu.NameSingular
 }
 
 // GetNamePlural returns the plural name of the time unit.
-func (gtu globalTimeUnit) GetNamePlural() string {
-	return gtu.namePlural
+func (gtu Unit) GetNamePlural() string {
+	return gtu.NamePlural
 }
 
 // GetNameAbbrev returns the abbreviated name of the time unit.
-func (gtu globalTimeUnit) GetNameAbbrev() string {
-	return gtu.nameAbbrev
+func (gtu Unit) GetNameAbbrev() string {
+	return gtu.NameAbbrev
+}
+
+// GetSize returns the length of a single unit.
+func (gtu Unit) GetSize() time.Duration {
+	return gtu.Size
 }
 
-// Predefined global time units for easy access and consistency across the package.
+// Predefined time units for easy access and consistency across the package.
 // These are used in both ShortProcessFormatter and LongProcessFormatter.
 // They are defined as global variables to avoid duplication and ensure consistent naming.
-// They are not meant to be modified and should be used as constants.
+// They are not meant to be modified and should be used as constants, copy them to build
+// custom units instead.
 // They are used to create timeUnit instances with specific values in the formatters.
 //
-//nolint:gochecknoglobals // These are constants for time units, not global state.
+//nolint:gochecknoglobals,mnd // These are constants for time units, not global state.
 var (
-	unitDay = globalTimeUnit{
-		nameSingular: "day", namePlural: "days", nameAbbrev: "d",
+	UnitWeek = Unit{
+		NameSingular: "week", NamePlural: "weeks", NameAbbrev: "w", Size: 7 * 24 * time.Hour,
+	}
+	UnitDay = Unit{
+		NameSingular: "day", NamePlural: "days", NameAbbrev: "d", Size: 24 * time.Hour,
 	}
-	unitHour = globalTimeUnit{
-		nameSingular: "hour", namePlural: "hours", nameAbbrev: "h",
+	UnitHour = Unit{
+		NameSingular: "hour", NamePlural: "hours", NameAbbrev: "h", Size: time.Hour,
 	}
-	unitMinute = globalTimeUnit{
-		nameSingular: "minute", namePlural: "minutes", nameAbbrev: "m",
+	UnitMinute = Unit{
+		NameSingular: "minute", NamePlural: "minutes", NameAbbrev: "m", Size: time.Minute,
 	}
-	unitSecond = globalTimeUnit{
-		nameSingular: "second", namePlural: "seconds", nameAbbrev: "s", showZero: true,
+	UnitSecond = Unit{
+		NameSingular: "second", NamePlural: "seconds", NameAbbrev: "s", Size: time.Second, ShowZero: true,
 	}
-	unitMillisecond = globalTimeUnit{
-		nameSingular: "millisecond", namePlural: "milliseconds", nameAbbrev: "ms", onlyIfSeconds: true, showZero: true,
+	UnitMillisecond = Unit{
+		NameSingular: "millisecond", NamePlural: "milliseconds", NameAbbrev: "ms", Size: time.Millisecond,
+		OnlyIfSeconds: true, ShowZero: true,
 	}
-	unitMicrosecond = globalTimeUnit{
-		nameSingular: "microsecond", namePlural: "microseconds", nameAbbrev: "µs", onlyIfSeconds: true, showZero: true,
+	UnitMicrosecond = Unit{
+		NameSingular: "microsecond", NamePlural: "microseconds", NameAbbrev: "µs", Size: time.Microsecond,
+		OnlyIfSeconds: true, ShowZero: true,
 	}
-	unitNanosecond = globalTimeUnit{
-		nameSingular: "nanosecond", namePlural: "nanoseconds", nameAbbrev: "ns", onlyIfSeconds: true, showZero: true,
+	UnitNanosecond = Unit{
+		NameSingular: "nanosecond", NamePlural: "nanoseconds", NameAbbrev: "ns", Size: time.Nanosecond,
+		OnlyIfSeconds: true, ShowZero: true,
 	}
 )
 
 // timeUnit stores information about a single unit of time.
 type timeUnit struct {
 	value int64
-	unit  globalTimeUnit // Reference to the global time unit definition
-}
-
-// IsGlobalUnit checks if the timeUnit corresponds to the given globalTimeUnit.
-func (tu timeUnit) IsGlobalUnit(gtu globalTimeUnit) bool {
-	return tu.unit == gtu
+	unit  Unit     // Reference to the unit definition
+	big   *big.Int // Value of the unit when it does not fit in value (see BigDuration)
 }
 
 // IsOnlyIfSeconds returns true if this time unit should only be shown if the total duration is less than 60 seconds.
@@ -115,18 +133,28 @@ func (tu timeUnit) GetNameAbbrev() string {
 
 // String returns the string representation of the time unit based on the formatting options.
 func (tu timeUnit) String(abbreviated, spaces bool) string {
+	if tu.big != nil {
+		return tu.format(tu.big.String(), abbreviated, spaces)
+	}
+
+	return tu.format(strconv.FormatInt(tu.value, 10), abbreviated, spaces)
+}
+
+// format returns the string representation of the time unit using the already formatted
+// number, based on the formatting options.
+func (tu timeUnit) format(num string, abbreviated, spaces bool) string {
 	if abbreviated {
-		return strconv.FormatInt(tu.value, 10) + tu.GetNameAbbrev()
+		return num + tu.GetNameAbbrev()
 	}
 	if tu.value == 1 && spaces {
-		return strconv.FormatInt(tu.value, 10) + " " + tu.GetNameSingular()
+		return num + " " + tu.GetNameSingular()
 	}
 	if tu.value == 1 && !spaces {
-		return strconv.FormatInt(tu.value, 10) + tu.GetNameSingular()
+		return num + tu.GetNameSingular()
 	}
 	if !spaces {
-		return strconv.FormatInt(tu.value, 10) + tu.GetNamePlural()
+		return num + tu.GetNamePlural()
 	}
 
-	return strconv.FormatInt(tu.value, 10) + " " + tu.GetNamePlural()
+	return num + " " + tu.GetNamePlural()
 }
@@ -0,0 +1,89 @@
+package timestring
+
+import (
+	"time"
+)
+
+// TotalSeconds is the ready-to-use Total Formatter displaying the total number of seconds (eg. "9000s").
+//
+//nolint:gochecknoglobals // pre initialised formatter.
+var TotalSeconds Formatter = TotalFormatter{unit: UnitSecond, abbreviated: true}
+
+// TotalFormatter is a Total Formatter.
+//
+// It displays the whole duration as a total of a single unit, like "9000s" or "150 minutes",
+// and is useful as an exact machine form alongside a human readable one.
+type TotalFormatter struct {
+	nounitspaces bool
+	abbreviated  bool
+	unit         Unit
+	precision    int
+	numbers      NumberFormatter
+}
+
+// NewTotalFormatter returns a Total Formatter that displays the total number of the supplied unit.
+func NewTotalFormatter(unit Unit) TotalFormatter {
+	return TotalFormatter{unit: unit}
+}
+
+// Option returns a Total Formatter with the applied options.
+// NoSpaces and ShowMSOnSeconds are not applicable.
+func (t TotalFormatter) Option(opts ...FormatterOption) Formatter {
+	for _, opt := range opts {
+		switch opt {
+		case NoSpaces:
+			// Not applicable for TotalFormatter
+		case NoUnitSpaces:
+			t.nounitspaces = true
+		case Abbreviated:
+			t.abbreviated = true
+		case ShowMSOnSeconds:
+			// Not applicable for TotalFormatter
+		}
+	}
+
+	return t
+}
+
+// WithPrecision returns a Total Formatter that displays precision digits of the fractional
+// part of the total (eg. "1.5s"), the default of zero truncates the total to a whole number.
+func (t TotalFormatter) WithPrecision(precision int) TotalFormatter {
+	t.precision = precision
+
+	return t
+}
+
+// WithNumberFormat returns a Total Formatter that formats the total using the supplied
+// number formatter, a nil formatter uses strconv.
+func (t TotalFormatter) WithNumberFormat(nf NumberFormatter) TotalFormatter {
+	t.numbers = nf
+
+	return t
+}
+
+// String returns the duration as a total of the unit of the Total Formatter.
+func (t TotalFormatter) String(td time.Duration) string {
+	unit := t.unit
+	if unit.Size <= 0 {
+		unit = UnitSecond
+	}
+
+	tu := unit.toTimeUnit(int64(td / unit.Size))
+	if t.precision <= 0 {
+		return tu.format(formatInt(t.numbers, tu.value), t.abbreviated, !t.nounitspaces)
+	}
+
+	nf := t.numbers
+	if nf == nil {
+		nf = NumberFormat{}
+	}
+
+	// Only a whole value of one is singular.
+	if td%unit.Size != 0 {
+		tu.value = 0
+	}
+
+	num := nf.FormatFloat(float64(td)/float64(unit.Size), t.precision)
+
+	return tu.format(num, t.abbreviated, !t.nounitspaces)
+}
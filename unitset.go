@@ -0,0 +1,128 @@
+package timestring
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// UnitSet is an ordered group of units that a formatter splits a duration into.
+//
+// Units are always kept in descending order of size, the zero value is an empty set
+// and causes the formatters to fall back to their default units.
+type UnitSet struct {
+	units *[]Unit // shared and never modified, a pointer keeps the formatters comparable
+}
+
+// NewUnitSet returns a UnitSet containing the supplied units, sorted from largest to smallest.
+// Units with a size of zero or less are ignored.
+func NewUnitSet(units ...Unit) UnitSet {
+	set := make([]Unit, 0, len(units))
+	for _, unit := range units {
+		if unit.Size > 0 {
+			set = append(set, unit)
+		}
+	}
+
+	slices.SortStableFunc(set, func(a, b Unit) int {
+		return cmp.Compare(b.Size, a.Size)
+	})
+
+	return UnitSet{units: &set}
+}
+
+// list returns the units in the set, the result must not be modified.
+func (us UnitSet) list() []Unit {
+	if us.units == nil {
+		return nil
+	}
+
+	return *us.units
+}
+
+// Predefined unit sets used by the standard formatters.
+//
+//nolint:gochecknoglobals // These are constants for unit sets, not global state.
+var (
+	// StandardUnits is the set of units used by LongProcess and ShortProcess (days down to milliseconds).
+	StandardUnits = NewUnitSet(UnitDay, UnitHour, UnitMinute, UnitSecond, UnitMillisecond)
+
+	// PreciseUnits is the set of units used by Absolute (days down to nanoseconds).
+	PreciseUnits = NewUnitSet(
+		UnitDay, UnitHour, UnitMinute, UnitSecond, UnitMillisecond, UnitMicrosecond, UnitNanosecond,
+	)
+)
+
+// Units returns a copy of the units in the set, from largest to smallest.
+func (us UnitSet) Units() []Unit {
+	return slices.Clone(us.list())
+}
+
+// Len returns the number of units in the set.
+func (us UnitSet) Len() int {
+	return len(us.list())
+}
+
+// IsEmpty returns true if the set does not contain any units.
+func (us UnitSet) IsEmpty() bool {
+	return len(us.list()) == 0
+}
+
+// Smallest returns the smallest unit in the set, or false if the set is empty.
+func (us UnitSet) Smallest() (Unit, bool) {
+	units := us.list()
+	if len(units) == 0 {
+		return Unit{}, false
+	}
+
+	return units[len(units)-1], true
+}
+
+// Split returns the value of each unit in the set for the duration, in the same order as Units().
+// The remainder smaller than the smallest unit is discarded.
+func (us UnitSet) Split(td time.Duration) []int64 {
+	units := us.list()
+	values := make([]int64, len(units))
+	for i, unit := range units {
+		values[i] = int64(td / unit.Size)
+		td -= time.Duration(values[i]) * unit.Size
+	}
+
+	return values
+}
+
+// orDefault returns the set, or def if the set is empty.
+func (us UnitSet) orDefault(def UnitSet) UnitSet {
+	if us.IsEmpty() {
+		return def
+	}
+
+	return us
+}
+
+// timeUnits splits the duration into timeUnit values for each unit in the set.
+func (us UnitSet) timeUnits(td time.Duration) []timeUnit {
+	values := us.Split(td)
+	set := us.list()
+	units := make([]timeUnit, len(set))
+	for i, unit := range set {
+		units[i] = unit.toTimeUnit(values[i])
+	}
+
+	return units
+}
+
+// zeroUnit returns the unit used to display a zero duration, this is the smallest unit flagged
+// with ShowZero that is not only shown for durations under a minute, falling back to the smallest unit.
+func (us UnitSet) zeroUnit() Unit {
+	units := us.list()
+	for i := len(units) - 1; i >= 0; i-- {
+		if units[i].ShowZero && !units[i].OnlyIfSeconds {
+			return units[i]
+		}
+	}
+
+	unit, _ := us.Smallest()
+
+	return unit
+}
@@ -0,0 +1,119 @@
+package timestring_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestNewUnitSetOrdering(t *testing.T) {
+	t.Parallel()
+
+	us := ts.NewUnitSet(ts.UnitSecond, ts.UnitDay, ts.Unit{NameAbbrev: "x"}, ts.UnitMinute)
+	if us.Len() != 3 {
+		t.Fatalf("NewUnitSet().Len() expected 3 units, got %d", us.Len())
+	}
+
+	expect := []ts.Unit{ts.UnitDay, ts.UnitMinute, ts.UnitSecond}
+	if got := us.Units(); !slices.Equal(got, expect) {
+		t.Errorf("NewUnitSet().Units() returned invalid order: expected(%v) got(%v)", expect, got)
+	}
+
+	if unit, ok := us.Smallest(); !ok || unit != ts.UnitSecond {
+		t.Errorf("NewUnitSet().Smallest() expected(%v) got(%v, %t)", ts.UnitSecond, unit, ok)
+	}
+
+	if _, ok := (ts.UnitSet{}).Smallest(); ok {
+		t.Error("UnitSet{}.Smallest() expected no unit on an empty set")
+	}
+}
+
+func TestUnitSetSplit(t *testing.T) {
+	t.Parallel()
+
+	us := ts.NewUnitSet(ts.UnitWeek, ts.UnitDay, ts.UnitHour)
+	values := us.Split(17*24*time.Hour + 5*time.Hour + 59*time.Minute)
+
+	if expect := []int64{2, 3, 5}; !slices.Equal(values, expect) {
+		t.Errorf("UnitSet.Split() expected(%v) got(%v)", expect, values)
+	}
+}
+
+func TestCustomUnitSetFormatters(t *testing.T) {
+	t.Parallel()
+
+	minute := ts.UnitMinute
+	minute.NameAbbrev = "min"
+	minute.NameSingular = "min"
+	minute.NamePlural = "mins"
+
+	tick := ts.Unit{
+		NameSingular: "tick", NamePlural: "ticks", NameAbbrev: "t", Size: 50 * time.Millisecond, ShowZero: true,
+	}
+
+	withMin := ts.NewUnitSet(ts.UnitWeek, ts.UnitDay, ts.UnitHour, minute, ts.UnitSecond)
+	ticks := ts.NewUnitSet(ts.UnitSecond, tick)
+	longMin := ts.LongProcessFormatter{}.WithUnits(withMin)
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		td   time.Duration
+		ex   string
+	}{
+		{"long/min", longMin, 216*time.Hour + 3*time.Minute, "1 week 2 days 3 mins"},
+		{"long/min/one", longMin, time.Minute, "1 min"},
+		{"long/abbrev", longMin.Option(ts.Abbreviated), 90 * time.Second, "1min 30s"},
+		{"long/ticks", ts.LongProcessFormatter{}.WithUnits(ticks), 1250 * time.Millisecond, "1 second 5 ticks"},
+		{"long/ticks/zero", ts.LongProcessFormatter{}.WithUnits(ticks), 10 * time.Millisecond, "0 seconds"},
+		{"short/min", ts.ShortProcessFormatter{}.WithUnits(withMin), 192*time.Hour + 2*time.Minute, "1w 1d 2min"},
+		{"short/ticks", ts.ShortProcessFormatter{}.WithUnits(ticks), 100 * time.Millisecond, "2t"},
+		{"short/zero", ts.ShortProcessFormatter{}.WithUnits(ts.NewUnitSet(tick)), 0, "0t"},
+		{"absolute/ticks", ts.AbsoluteFormatter{}.WithUnits(ticks), 2*time.Second + 150*time.Millisecond, "2s 3t"},
+		{"absolute/empty", ts.AbsoluteFormatter{}.WithUnits(ts.UnitSet{}), 1500 * time.Nanosecond, "1µs 500ns"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(tc.td); o != tc.ex {
+				t.Errorf("Formatter.String() returned invalid duration(%s): expected(%s) got(%s)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestUnitSetFormattersComparable(t *testing.T) {
+	t.Parallel()
+
+	custom := ts.NewUnitSet(ts.UnitHour, ts.UnitMinute)
+	formatters := []ts.Formatter{
+		ts.LongProcess, ts.ShortProcess, ts.Absolute,
+		ts.LongProcessFormatter{}.WithUnits(custom),
+		ts.ShortProcessFormatter{}.WithUnits(custom),
+		ts.AbsoluteFormatter{}.WithUnits(custom),
+	}
+
+	names := map[ts.Formatter]int{}
+	for i, f := range formatters {
+		names[f] = i
+	}
+
+	for i, f := range formatters {
+		if names[f] != i {
+			t.Errorf("map[Formatter] returned invalid index for formatter(%d): got(%d)", i, names[f])
+		}
+
+		var same ts.Formatter = f
+		if same != formatters[i] {
+			t.Errorf("Formatter(%d) expected to equal itself", i)
+		}
+	}
+
+	if ts.LongProcess == ts.Absolute {
+		t.Error("LongProcess expected to not equal Absolute")
+	}
+}
@@ -0,0 +1,187 @@
+package timestring
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// WorkingHours are the working hours of a single day as offsets from midnight
+// (eg. 9*time.Hour to 17*time.Hour), a day with an End not after Start is not a working day.
+type WorkingHours struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// IsWorkingDay returns true if the working hours contain any working time.
+func (wh WorkingHours) IsWorkingDay() bool {
+	return wh.End > wh.Start
+}
+
+// NewWorkingUnits returns a UnitSet of working time where a business day is hoursPerDay long and
+// a business week is daysPerWeek business days, for displaying durations returned by WorkingTime.
+func NewWorkingUnits(hoursPerDay time.Duration, daysPerWeek int) UnitSet {
+	return NewUnitSet(
+		Unit{
+			NameSingular: "business week", NamePlural: "business weeks", NameAbbrev: "bw",
+			Size: hoursPerDay * time.Duration(daysPerWeek),
+		},
+		Unit{
+			NameSingular: "business day", NamePlural: "business days", NameAbbrev: "bd",
+			Size: hoursPerDay,
+		},
+		UnitHour,
+		UnitMinute,
+		UnitSecond,
+	)
+}
+
+// WorkingUnits is the UnitSet of working time where 1 business day is 8 hours and 1 business week is 5 days.
+//
+//nolint:gochecknoglobals,mnd // These are constants for unit sets, not global state.
+var WorkingUnits = NewWorkingUnits(8*time.Hour, 5)
+
+// WorkingProcess is the ready-to-use Long Process Formatter for working time using WorkingUnits
+// (eg. "3 business days 2 hours").
+//
+//nolint:gochecknoglobals // pre initialised formatter.
+var WorkingProcess Formatter = LongProcessFormatter{units: WorkingUnits}
+
+// calendarDate is a date without a time or location.
+type calendarDate struct {
+	year  int
+	month time.Month
+	day   int
+}
+
+// WorkingCalendar computes the elapsed working time between timestamps, excluding time outside
+// the working hours of each weekday and holidays.
+type WorkingCalendar struct {
+	Location *time.Location  // Location of the working hours and holidays, defaults to time.Local
+	Hours    [7]WorkingHours // Working hours indexed by time.Weekday
+	holidays map[calendarDate]struct{}
+}
+
+// NewWorkingCalendar returns a WorkingCalendar in the location with working hours of 9:00 to 17:00
+// Monday to Friday.
+//
+//nolint:mnd // default working hours.
+func NewWorkingCalendar(loc *time.Location) *WorkingCalendar {
+	c := &WorkingCalendar{Location: loc}
+	for day := time.Monday; day <= time.Friday; day++ {
+		c.Hours[day] = WorkingHours{Start: 9 * time.Hour, End: 17 * time.Hour}
+	}
+
+	return c
+}
+
+// location returns the location of the calendar, or time.Local if it is not set.
+func (c *WorkingCalendar) location() *time.Location {
+	if c.Location == nil {
+		return time.Local
+	}
+
+	return c.Location
+}
+
+// AddHoliday adds the date (in the location of the calendar) as a holiday.
+func (c *WorkingCalendar) AddHoliday(date time.Time) {
+	if c.holidays == nil {
+		c.holidays = map[calendarDate]struct{}{}
+	}
+
+	y, m, d := date.In(c.location()).Date()
+	c.holidays[calendarDate{y, m, d}] = struct{}{}
+}
+
+// IsHoliday returns true if the date (in the location of the calendar) is a holiday.
+func (c *WorkingCalendar) IsHoliday(date time.Time) bool {
+	y, m, d := date.In(c.location()).Date()
+	_, ok := c.holidays[calendarDate{y, m, d}]
+
+	return ok
+}
+
+// LoadHolidays adds the holidays listed in r, one date per line in the format "2006-01-02"
+// optionally followed by whitespace and a name, blank lines and lines starting with "#" are ignored.
+//
+//	# Public holidays
+//	2026-12-25 Christmas Day
+//	2026-12-26 Boxing Day
+func (c *WorkingCalendar) LoadHolidays(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		date, err := time.ParseInLocation(time.DateOnly, strings.Fields(text)[0], c.location())
+		if err != nil {
+			return fmt.Errorf("invalid holiday on line %d: %w", line, err)
+		}
+
+		c.AddHoliday(date)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("unable to read holidays: %w", err)
+	}
+
+	return nil
+}
+
+// LoadHolidaysFile adds the holidays listed in the named file, see LoadHolidays for the format.
+func (c *WorkingCalendar) LoadHolidaysFile(name string) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return fmt.Errorf("unable to open holidays: %w", err)
+	}
+	defer f.Close()
+
+	return c.LoadHolidays(f)
+}
+
+// WorkingTime returns the working time elapsed between from and to, the result is negative if to
+// is before from.
+func (c *WorkingCalendar) WorkingTime(from, to time.Time) time.Duration {
+	if to.Before(from) {
+		return -c.WorkingTime(to, from)
+	}
+
+	loc := c.location()
+	from, to = from.In(loc), to.In(loc)
+
+	var total time.Duration
+
+	y, m, d := from.Date()
+	for day := time.Date(y, m, d, 0, 0, 0, 0, loc); day.Before(to); day = time.Date(y, m, d+1, 0, 0, 0, 0, loc) {
+		y, m, d = day.Date()
+
+		hours := c.Hours[day.Weekday()]
+		if !hours.IsWorkingDay() || c.IsHoliday(day) {
+			continue
+		}
+
+		// Build from the wall clock so that daylight saving changes are handled.
+		start := time.Date(y, m, d, 0, 0, 0, int(hours.Start), loc)
+		end := time.Date(y, m, d, 0, 0, 0, int(hours.End), loc)
+
+		if start.Before(from) {
+			start = from
+		}
+
+		if end.After(to) {
+			end = to
+		}
+
+		if end.After(start) {
+			total += end.Sub(start)
+		}
+	}
+
+	return total
+}
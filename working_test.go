@@ -0,0 +1,125 @@
+package timestring_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestWorkingCalendarWorkingTime(t *testing.T) {
+	t.Parallel()
+
+	cal := ts.NewWorkingCalendar(time.UTC)
+	cal.AddHoliday(time.Date(2026, time.March, 4, 0, 0, 0, 0, time.UTC))
+
+	// 2026-03-02 is a Monday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2026, time.March, day, hour, minute, 0, 0, time.UTC)
+	}
+
+	tcs := []struct {
+		name     string
+		from, to time.Time
+		ex       time.Duration
+	}{
+		{"same-day", at(2, 10, 0), at(2, 12, 30), 150 * time.Minute},
+		{"before-hours", at(2, 6, 0), at(2, 10, 0), time.Hour},
+		{"overnight", at(2, 16, 0), at(3, 10, 0), 2 * time.Hour},
+		{"holiday", at(3, 9, 0), at(5, 9, 0), 8 * time.Hour},
+		{"weekend", at(6, 15, 0), at(9, 11, 0), 4 * time.Hour},
+		{"week", at(2, 0, 0), at(9, 0, 0), 32 * time.Hour},
+		{"reversed", at(2, 12, 30), at(2, 10, 0), -150 * time.Minute},
+		{"outside", at(7, 9, 0), at(7, 17, 0), 0},
+	}
+
+	for _, tc := range tcs {
+		if o := cal.WorkingTime(tc.from, tc.to); o != tc.ex {
+			t.Errorf("%s: WorkingCalendar.WorkingTime(%s, %s) expected(%s) got(%s)", tc.name, tc.from, tc.to, tc.ex, o)
+		}
+	}
+}
+
+func TestWorkingCalendarLocation(t *testing.T) {
+	t.Parallel()
+
+	loc := time.FixedZone("AEST", 10*60*60)
+	cal := ts.NewWorkingCalendar(loc)
+
+	// 2026-03-02 09:00 AEST is 2026-03-01 23:00 UTC.
+	from := time.Date(2026, time.March, 1, 22, 0, 0, 0, time.UTC)
+	to := time.Date(2026, time.March, 2, 1, 0, 0, 0, time.UTC)
+
+	if o := cal.WorkingTime(from, to); o != 2*time.Hour {
+		t.Errorf("WorkingCalendar.WorkingTime() expected(2h) got(%s)", o)
+	}
+}
+
+func TestWorkingCalendarLoadHolidays(t *testing.T) {
+	t.Parallel()
+
+	cal := ts.NewWorkingCalendar(time.UTC)
+
+	holidays := "# Public holidays\n\n2026-12-25 Christmas Day\n2026-12-26\tBoxing Day\n2026-12-28\n"
+	if err := cal.LoadHolidays(strings.NewReader(holidays)); err != nil {
+		t.Fatalf("LoadHolidays() unexpected error: %s", err)
+	}
+
+	for _, day := range []int{25, 26, 28} {
+		if !cal.IsHoliday(time.Date(2026, time.December, day, 12, 0, 0, 0, time.UTC)) {
+			t.Errorf("IsHoliday(2026-12-%d) expected holiday", day)
+		}
+	}
+
+	if cal.IsHoliday(time.Date(2026, time.December, 24, 12, 0, 0, 0, time.UTC)) {
+		t.Error("IsHoliday(2026-12-24) expected working day")
+	}
+
+	if err := cal.LoadHolidays(strings.NewReader("2026-12-25\nChristmas\n")); err == nil {
+		t.Error("LoadHolidays() expected error for invalid date")
+	}
+
+	name := filepath.Join(t.TempDir(), "holidays.txt")
+	if err := os.WriteFile(name, []byte("2027-01-01 New Year's Day\n"), 0o600); err != nil {
+		t.Fatalf("unable to write holidays: %s", err)
+	}
+
+	if err := cal.LoadHolidaysFile(name); err != nil {
+		t.Fatalf("LoadHolidaysFile() unexpected error: %s", err)
+	}
+
+	if !cal.IsHoliday(time.Date(2027, time.January, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsHoliday(2027-01-01) expected holiday")
+	}
+
+	if err := cal.LoadHolidaysFile(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Error("LoadHolidaysFile() expected error for missing file")
+	}
+}
+
+func TestWorkingProcess(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		td time.Duration
+		ex string
+	}{
+		{26 * time.Hour, "3 business days 2 hours"},
+		{48 * time.Hour, "1 business week 1 business day"},
+		{0, "0 seconds"},
+	}
+
+	for _, tc := range tcs {
+		if o := ts.WorkingProcess.String(tc.td); o != tc.ex {
+			t.Errorf("WorkingProcess.String(%s) expected(%s) got(%s)", tc.td, tc.ex, o)
+		}
+	}
+
+	f := ts.LongProcessFormatter{}.WithUnits(ts.NewWorkingUnits(7*time.Hour+30*time.Minute, 5)).Option(ts.Abbreviated)
+	if o := f.String(15 * time.Hour); o != "2bd" {
+		t.Errorf("LongProcess.WithUnits(NewWorkingUnits()).String() expected(2bd) got(%s)", o)
+	}
+}
@@ -0,0 +1,86 @@
+package timestring
+
+import (
+	"time"
+)
+
+// WorkLog is the ready-to-use Work Log Formatter with 8 hour days and 5 day weeks.
+//
+//nolint:gochecknoglobals,mnd // pre initialised formatter.
+var WorkLog Formatter = NewWorkLogFormatter(8*time.Hour, 5)
+
+// WorkLogFormatter is a Work Log Formatter.
+//
+// It displays durations in the work-log notation used by issue trackers such as Jira
+// (eg. "1w 2d 3h 30m"), where a day and a week are lengths of working time rather than
+// calendar time. Durations are truncated to minutes.
+type WorkLogFormatter struct {
+	nospaces    bool
+	hoursPerDay time.Duration
+	daysPerWeek int
+}
+
+// NewWorkLogFormatter returns a Work Log Formatter where a day is hoursPerDay long and a week
+// is daysPerWeek days.
+func NewWorkLogFormatter(hoursPerDay time.Duration, daysPerWeek int) WorkLogFormatter {
+	return WorkLogFormatter{hoursPerDay: hoursPerDay, daysPerWeek: daysPerWeek}
+}
+
+// Option returns a Work Log Formatter with the applied options.
+// NoUnitSpaces, Abbreviated and ShowMSOnSeconds are not applicable.
+func (w WorkLogFormatter) Option(opts ...FormatterOption) Formatter {
+	for _, opt := range opts {
+		switch opt {
+		case NoSpaces:
+			w.nospaces = true
+		case NoUnitSpaces:
+			// Not applicable for WorkLogFormatter
+		case Abbreviated:
+			// Always abbreviated
+		case ShowMSOnSeconds:
+			// Not applicable for WorkLogFormatter
+		}
+	}
+
+	return w
+}
+
+// Units returns the UnitSet of the work-log notation (weeks, days, hours and minutes).
+//
+//nolint:mnd // default working time.
+func (w WorkLogFormatter) Units() UnitSet {
+	hoursPerDay, daysPerWeek := w.hoursPerDay, w.daysPerWeek
+	if hoursPerDay <= 0 {
+		hoursPerDay = 8 * time.Hour
+	}
+
+	if daysPerWeek <= 0 {
+		daysPerWeek = 5
+	}
+
+	week, day := UnitWeek, UnitDay
+	week.Size = hoursPerDay * time.Duration(daysPerWeek)
+	day.Size = hoursPerDay
+
+	return NewUnitSet(week, day, UnitHour, UnitMinute)
+}
+
+// String returns the duration in work-log notation, such as "1w 2d 3h 30m" or "0m".
+func (w WorkLogFormatter) String(td time.Duration) string {
+	f := ShortProcessFormatter{nospaces: w.nospaces}.WithUnits(w.Units())
+
+	return f.String(td)
+}
+
+// Parse parses a duration in work-log notation (eg. "1w 2d 3h 30m", "1.5d"), the full unit
+// names and other forms accepted by ParseWithUnits are also accepted.
+func (w WorkLogFormatter) Parse(s string) (time.Duration, error) {
+	return ParseWithUnits(s, w.Units())
+}
+
+// ParseWorkLog parses a duration in work-log notation with 8 hour days and 5 day weeks.
+//
+//nolint:mnd // default working time.
+func ParseWorkLog(s string) (time.Duration, error) {
+	return NewWorkLogFormatter(8*time.Hour, 5).Parse(s)
+}
@@ -0,0 +1,74 @@
+package timestring_test
+
+import (
+	"testing"
+	"time"
+
+	ts "github.com/na4ma4/go-timestring"
+)
+
+func TestWorkLogFormatter(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		name string
+		f    ts.Formatter
+		td   time.Duration
+		ex   string
+	}{
+		{"full", ts.WorkLog, 40*time.Hour + 16*time.Hour + 3*time.Hour + 30*time.Minute, "1w 2d 3h 30m"},
+		{"day", ts.WorkLog, 8 * time.Hour, "1d"},
+		{"hours", ts.WorkLog, 7 * time.Hour, "7h"},
+		{"truncated", ts.WorkLog, 90*time.Second + 500*time.Millisecond, "1m"},
+		{"zero", ts.WorkLog, 0, "0m"},
+		{"nospaces", ts.WorkLog.Option(ts.NoSpaces), 59*time.Hour + 30*time.Minute, "1w2d3h30m"},
+		{"custom", ts.NewWorkLogFormatter(7*time.Hour+30*time.Minute, 4), 31 * time.Hour, "1w 1h"},
+		{"unset", ts.WorkLogFormatter{}, 48 * time.Hour, "1w 1d"},
+	}
+
+	for _, tc := range tcs {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if o := tc.f.String(tc.td); o != tc.ex {
+				t.Errorf("Formatter.String(%s) expected(%s) got(%s)", tc.td, tc.ex, o)
+			}
+		})
+	}
+}
+
+func TestWorkLogParse(t *testing.T) {
+	t.Parallel()
+
+	tcs := []struct {
+		in string
+		ex time.Duration
+	}{
+		{"1w 2d 3h 30m", 59*time.Hour + 30*time.Minute},
+		{"1w2d3h30m", 59*time.Hour + 30*time.Minute},
+		{"1.5d", 12 * time.Hour},
+		{"2 weeks", 80 * time.Hour},
+		{"0m", 0},
+	}
+
+	for _, tc := range tcs {
+		o, err := ts.ParseWorkLog(tc.in)
+		if err != nil {
+			t.Errorf("ParseWorkLog(%q) unexpected error: %s", tc.in, err)
+
+			continue
+		}
+
+		if o != tc.ex {
+			t.Errorf("ParseWorkLog(%q) expected(%s) got(%s)", tc.in, tc.ex, o)
+		}
+	}
+
+	if o, err := ts.NewWorkLogFormatter(6*time.Hour, 4).Parse("1w 1d"); err != nil || o != 30*time.Hour {
+		t.Errorf("WorkLogFormatter.Parse(\"1w 1d\") expected(30h) got(%s, %v)", o, err)
+	}
+
+	if _, err := ts.ParseWorkLog("3s"); err == nil {
+		t.Error("ParseWorkLog(\"3s\") expected error for seconds")
+	}
+}